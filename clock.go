@@ -0,0 +1,168 @@
+package gotime
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Clock represents a wall clock time without a date or a time zone, such as
+// the time a store opens. Arithmetic on a Clock wraps around midnight.
+type Clock struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// ClockOf returns the Clock of a time.Time in that time's location.
+func ClockOf(t time.Time) Clock {
+	var c Clock
+	c.Hour, c.Minute, c.Second = t.Clock()
+	c.Nanosecond = t.Nanosecond()
+	return c
+}
+
+// ParseClock parses a time of day using TimeParser, so it accepts any of
+// the formats supported there, with or without the leading T. If the string
+// includes an offset, the time is taken as written, ignoring the offset.
+// Note that 24:00 becomes 00:00, as a Clock cannot represent the end of the day.
+func ParseClock(str string) (Clock, error) {
+	if !strings.HasPrefix(str, "T") {
+		str = "T" + str
+	}
+	timefmt, err := TimeParser(str)
+	if err != nil {
+		return Clock{}, errors.Wrap(err, NewParseError(str, "Parsing time format"))
+	}
+
+	t, err := parse(timefmt, str)
+	if err != nil {
+		return Clock{}, err
+	}
+	return ClockOf(t), nil
+}
+
+// String returns the clock in HH:MM:SS format, followed by
+// fractional seconds if there are any.
+func (c Clock) String() string {
+	return c.On(Date{2000, time.January, 1}, time.UTC).Format("15:04:05.999999999")
+}
+
+// IsValid determines whether the clock is a real time of day, eg it is not 25:00.
+func (c Clock) IsValid() bool {
+	return c.Hour >= 0 && c.Hour < 24 && c.Minute >= 0 && c.Minute < 60 &&
+		c.Second >= 0 && c.Second < 60 && c.Nanosecond >= 0 && c.Nanosecond < int(time.Second)
+}
+
+// On returns a time.Time at the clock time on the given date in the given location.
+// It is the same as date.At(c, loc).
+func (c Clock) On(date Date, loc *time.Location) time.Time {
+	return date.At(c, loc)
+}
+
+// SinceMidnight returns the time elapsed since midnight, ignoring
+// daylight savings time.
+func (c Clock) SinceMidnight() time.Duration {
+	return time.Duration(c.Hour)*time.Hour + time.Duration(c.Minute)*time.Minute +
+		time.Duration(c.Second)*time.Second + time.Duration(c.Nanosecond)
+}
+
+// Add returns the clock d after c, wrapping around midnight. d may be negative.
+func (c Clock) Add(d time.Duration) Clock {
+	const day = 24 * time.Hour
+	d = (c.SinceMidnight() + d%day + day) % day
+	return ClockOf(time.Time{}.Add(d))
+}
+
+// Sub returns the duration from other to c, from -24h to 24h exclusive.
+func (c Clock) Sub(other Clock) time.Duration {
+	return c.SinceMidnight() - other.SinceMidnight()
+}
+
+// Before determines whether c is earlier in the day than other.
+func (c Clock) Before(other Clock) bool {
+	return c.Sub(other) < 0
+}
+
+// After determines whether c is later in the day than other.
+func (c Clock) After(other Clock) bool {
+	return c.Sub(other) > 0
+}
+
+// Equal determines whether c and other are the same time of day.
+func (c Clock) Equal(other Clock) bool {
+	return c.Sub(other) == 0
+}
+
+// Compare returns -1 if c is before other, 0 if they are equal, and 1 if c is after other.
+func (c Clock) Compare(other Clock) int {
+	switch d := c.Sub(other); {
+	case d < 0:
+		return -1
+	case d > 0:
+		return 1
+	}
+	return 0
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c Clock) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// An empty string results in midnight.
+func (c *Clock) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*c = Clock{}
+		return nil
+	}
+
+	res, err := ParseClock(string(data))
+	if err != nil {
+		return err
+	}
+	*c = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c Clock) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", c)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both null and an empty string result in midnight.
+func (c *Clock) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = Clock{}
+		return nil
+	}
+	return c.UnmarshalText([]byte(strings.Trim(string(data), "\"")))
+}
+
+// Value implements the driver.Valuer interface for use with TIME columns.
+// The clock is passed to the driver as a string in HH:MM:SS format.
+func (c Clock) Value() (driver.Value, error) {
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts a time.Time, in which
+// case the clock is taken in the time's location, or a string or byte slice.
+func (c *Clock) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case time.Time:
+		*c = ClockOf(v)
+	case string:
+		return c.UnmarshalText([]byte(v))
+	case []byte:
+		return c.UnmarshalText(v)
+	default:
+		return errors.Errorf("Error converting %T to clock", value)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package gotime_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime"
+)
+
+var _ = Describe("Clock", func() {
+	var ref Clock
+
+	BeforeEach(func() {
+		ref = Clock{Hour: 9, Minute: 30}
+	})
+
+	It("should parse any supported time format", func() {
+		for _, str := range []string{"09:30", "T09:30:00", "0930", "T093000Z", "09:30:00-05:00"} {
+			Expect(ParseClock(str)).To(Equal(ref), str)
+		}
+		Expect(ParseClock("10:15:30.5")).To(Equal(Clock{10, 15, 30, 500000000}))
+	})
+
+	It("should format as HH:MM:SS", func() {
+		Expect(ref.String()).To(Equal("09:30:00"))
+		Expect(Clock{10, 15, 30, 500000000}.String()).To(Equal("10:15:30.5"))
+	})
+
+	It("should validate clocks", func() {
+		Expect(ref.IsValid()).To(BeTrue())
+		Expect(Clock{Hour: 24}.IsValid()).To(BeFalse())
+		Expect(Clock{Minute: -1}.IsValid()).To(BeFalse())
+	})
+
+	It("should not shift across daylight savings time", func() {
+		chicago, err := time.LoadLocation("America/Chicago")
+		Expect(err).NotTo(HaveOccurred())
+		t := ref.On(Date{2018, time.March, 11}, chicago)
+		Expect(ClockOf(t)).To(Equal(ref))
+		Expect(t.Sub(Date{2018, time.March, 11}.In(chicago))).To(Equal(8*time.Hour + 30*time.Minute))
+	})
+
+	Describe("arithmetic", func() {
+		It("should add durations with wraparound", func() {
+			Expect(ref.Add(90 * time.Minute)).To(Equal(Clock{Hour: 11}))
+			Expect(ref.Add(15 * time.Hour)).To(Equal(Clock{Hour: 0, Minute: 30}))
+			Expect(ref.Add(-10 * time.Hour)).To(Equal(Clock{Hour: 23, Minute: 30}))
+			Expect(ref.Add(-49 * time.Hour)).To(Equal(Clock{Hour: 8, Minute: 30}))
+		})
+
+		It("should compare clocks", func() {
+			later := ref.Add(time.Nanosecond)
+			Expect(later.Sub(ref)).To(Equal(time.Nanosecond))
+			Expect(ref.Before(later)).To(BeTrue())
+			Expect(later.After(ref)).To(BeTrue())
+			Expect(ref.Equal(Clock{Hour: 9, Minute: 30})).To(BeTrue())
+			Expect(ref.Compare(later)).To(Equal(-1))
+			Expect(later.Compare(ref)).To(Equal(1))
+			Expect(ref.Compare(ref)).To(Equal(0))
+		})
+	})
+
+	Describe("encoding", func() {
+		It("should round trip JSON", func() {
+			var v struct{ Opens Clock }
+			in := `{"Opens":"09:30:00"}`
+			Expect(json.Unmarshal([]byte(in), &v)).To(Succeed())
+			Expect(v.Opens).To(Equal(ref))
+			Expect(json.Marshal(v)).To(Equal([]byte(in)))
+		})
+
+		It("should scan and value SQL times", func() {
+			var c Clock
+			Expect(c.Scan(time.Date(0, time.January, 1, 9, 30, 0, 0, time.UTC))).To(Succeed())
+			Expect(c).To(Equal(ref))
+			Expect(c.Scan("09:30:00")).To(Succeed())
+			Expect(c).To(Equal(ref))
+			Expect(c.Value()).To(Equal("09:30:00"))
+			Expect(c.Scan(42)).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,232 @@
+/*
+Package cron parses cron expressions and calculates when they fire.
+
+A Schedule is parsed from a standard five-field expression such as
+"30 9 * * MON-FRI", a six-field expression with seconds, or a descriptor such
+as @daily or @every 90m; see Parse for the details, including the L, W, and #
+extensions for things like the last Friday of the month. Next and Prev return
+the fire times after and before a given time.
+
+Schedules are evaluated on the wall clock of their Location, so 30 9 * * *
+fires at 9:30 every morning whether or not daylight savings time is in effect.
+When the clocks spring forward, times in the skipped hour fire at the moment
+of the change, and when they fall back, times in the repeated hour fire once,
+the first time around. A Schedule can also skip the days in a holiday.List,
+eg to avoid running a job on days the markets are closed.
+*/
+package cron
+
+import (
+	"time"
+
+	"github.com/onwsk8r/gotime/holiday"
+)
+
+// maxYears is how far Next and Prev search before deciding that a
+// schedule such as 0 0 30 2 * never fires.
+const maxYears = 400
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	// Location is where the schedule is evaluated. If it is nil, each time
+	// is evaluated in its own location.
+	Location *time.Location
+
+	// Holidays are days the schedule does not fire. The observed dates of
+	// the holidays are skipped, as with holiday.List.Observes.
+	Holidays holiday.List
+
+	spec                        string
+	second, minute, hour, month uint64 // A bit is set for each value in the field
+	dom, dow                    uint64
+	domStar, dowStar            bool
+	domSpecial, dowSpecial      []dayMatcher
+	every                       time.Duration
+}
+
+// MustParse is like Parse but panics if the expression cannot be parsed.
+// It simplifies the initialization of global variables holding schedules.
+func MustParse(spec string) Schedule {
+	s, err := Parse(spec)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// String returns the expression the schedule was parsed from.
+func (s Schedule) String() string {
+	return s.spec
+}
+
+// Next returns the first time after t that the schedule fires, or the zero
+// time if it never does. An @every schedule fires every interval after t,
+// rounded down to the second.
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.In(s.location(t))
+	if s.every > 0 {
+		next := t.Truncate(time.Second).Add(s.every)
+		for s.isHoliday(next) {
+			next = next.Add(s.every)
+		}
+		return next
+	}
+
+	y, m, d := t.Date()
+	for day := date(y, m, d); day.Year() <= t.Year()+maxYears; day = day.AddDate(0, 0, 1) {
+		if s.month&(1<<uint(day.Month())) == 0 {
+			day = date(day.Year(), day.Month()+1, 0) // Skip to the end of the month
+			continue
+		}
+		if !s.matchesDay(day) {
+			continue
+		}
+
+		firstDay := day.Equal(date(y, m, d))
+		for _, hh := range values(s.hour, 0, 23) {
+			if firstDay && hh < t.Hour() {
+				continue
+			}
+			for _, mm := range values(s.minute, 0, 59) {
+				for _, ss := range values(s.second, 0, 59) {
+					if next := at(day, hh, mm, ss, t.Location()); next.After(t) {
+						return next
+					}
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// Prev returns the last time before t that the schedule fired, or the zero
+// time if it never did. For an @every schedule that is one interval before t,
+// rounded down to the second.
+func (s Schedule) Prev(t time.Time) time.Time {
+	t = t.In(s.location(t))
+	if s.every > 0 {
+		prev := t.Truncate(time.Second).Add(-s.every)
+		for s.isHoliday(prev) {
+			prev = prev.Add(-s.every)
+		}
+		return prev
+	}
+
+	y, m, d := t.Date()
+	for day := date(y, m, d); day.Year() >= t.Year()-maxYears; day = day.AddDate(0, 0, -1) {
+		if s.month&(1<<uint(day.Month())) == 0 {
+			day = date(day.Year(), day.Month(), 1) // Skip to the start of the month
+			continue
+		}
+		if !s.matchesDay(day) {
+			continue
+		}
+
+		// A time in the hour after t's can be before t when the clocks fall back
+		firstDay := day.Equal(date(y, m, d))
+		hours, minutes, seconds := values(s.hour, 0, 23), values(s.minute, 0, 59), values(s.second, 0, 59)
+		for i := len(hours) - 1; i >= 0; i-- {
+			if firstDay && hours[i] > t.Hour()+1 {
+				continue
+			}
+			for j := len(minutes) - 1; j >= 0; j-- {
+				for k := len(seconds) - 1; k >= 0; k-- {
+					if prev := at(day, hours[i], minutes[j], seconds[k], t.Location()); prev.Before(t) {
+						return prev
+					}
+				}
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// location returns where the schedule is evaluated for t.
+func (s Schedule) location(t time.Time) *time.Location {
+	if s.Location != nil {
+		return s.Location
+	}
+	return t.Location()
+}
+
+// matchesDay determines whether the schedule fires on a day, which is
+// midnight UTC. The month has already been checked.
+func (s Schedule) matchesDay(day time.Time) bool {
+	y, m, d := day.Date()
+	dom := s.dom&(1<<uint(d)) != 0
+	for _, match := range s.domSpecial {
+		dom = dom || match(y, m, d)
+	}
+	dow := s.dow&(1<<uint(day.Weekday())) != 0
+	for _, match := range s.dowSpecial {
+		dow = dow || match(y, m, d)
+	}
+
+	var res bool
+	switch {
+	case s.domStar && s.dowStar:
+		res = true
+	case s.domStar:
+		res = dow
+	case s.dowStar:
+		res = dom
+	default:
+		res = dom || dow
+	}
+	return res && !s.isHoliday(day)
+}
+
+// isHoliday determines whether t is an observed holiday in s.Holidays.
+func (s Schedule) isHoliday(t time.Time) bool {
+	if len(s.Holidays) == 0 {
+		return false
+	}
+	y, m, d := t.Date()
+	return s.Holidays.Observes(date(y, m, d))
+}
+
+// at returns the time on a day at the given wall clock time in loc. If the
+// clocks skip over that time, it returns the moment they change instead.
+func at(day time.Time, hh, mm, ss int, loc *time.Location) time.Time {
+	y, m, d := day.Date()
+	t := time.Date(y, m, d, hh, mm, ss, 0, loc)
+	want := time.Date(y, m, d, hh, mm, ss, 0, time.UTC)
+	if wall(t).Equal(want) {
+		return t
+	}
+
+	// Find the first moment whose wall clock time is at least the one we want
+	lo, hi := t.Add(-3*time.Hour), t.Add(3*time.Hour)
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		if wall(mid).Before(want) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi.Truncate(time.Second)
+}
+
+// wall returns the wall clock time of t as if it were in UTC.
+func wall(t time.Time) time.Time {
+	y, m, d := t.Date()
+	hh, mm, ss := t.Clock()
+	return time.Date(y, m, d, hh, mm, ss, 0, time.UTC)
+}
+
+// date returns midnight UTC on a date.
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// values returns the values in a bit set from min to max in order.
+func values(bits uint64, min, max int) []int {
+	var res []int
+	for i := min; i <= max; i++ {
+		if bits&(1<<uint(i)) != 0 {
+			res = append(res, i)
+		}
+	}
+	return res
+}
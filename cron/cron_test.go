@@ -0,0 +1,150 @@
+package cron_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/cron"
+	"github.com/onwsk8r/gotime/holiday"
+)
+
+var newYork, _ = time.LoadLocation("America/New_York")
+
+// at returns a time in New York.
+func at(year int, month time.Month, day, hour, min, sec int) time.Time {
+	return time.Date(year, month, day, hour, min, sec, 0, newYork)
+}
+
+var _ = Describe("Schedule", func() {
+	// Friday, July 13th, 2018 at 10:15:30
+	var now = at(2018, time.July, 13, 10, 15, 30)
+
+	var next = map[string]time.Time{
+		"* * * * *":           at(2018, time.July, 13, 10, 16, 0),
+		"* * * * * *":         at(2018, time.July, 13, 10, 15, 31),
+		"*/20 * * * * *":      at(2018, time.July, 13, 10, 15, 40),
+		"30 9 * * *":          at(2018, time.July, 14, 9, 30, 0),
+		"30 9 * * MON-FRI":    at(2018, time.July, 16, 9, 30, 0),
+		"0 0 1 * *":           at(2018, time.August, 1, 0, 0, 0),
+		"0 0 29 2 *":          at(2020, time.February, 29, 0, 0, 0),
+		"0 12 13 * FRI":       at(2018, time.July, 13, 12, 0, 0),
+		"0 9 1 * SAT":         at(2018, time.July, 14, 9, 0, 0),
+		"0 0 L * *":           at(2018, time.July, 31, 0, 0, 0),
+		"0 0 L-2 * *":         at(2018, time.July, 29, 0, 0, 0),
+		"0 0 LW 9 *":          at(2018, time.September, 28, 0, 0, 0),
+		"0 0 1W 9 *":          at(2018, time.September, 3, 0, 0, 0),
+		"0 0 15W 9 *":         at(2018, time.September, 14, 0, 0, 0),
+		"0 0 30W 9 *":         at(2018, time.September, 28, 0, 0, 0),
+		"0 0 * * FRIL":        at(2018, time.July, 27, 0, 0, 0),
+		"0 0 * * THU#4":       at(2018, time.July, 26, 0, 0, 0),
+		"0 0 * NOV 4#4":       at(2018, time.November, 22, 0, 0, 0),
+		"0 0 * * 5#5":         at(2018, time.August, 31, 0, 0, 0),
+		"0 0 * * 0":           at(2018, time.July, 15, 0, 0, 0),
+		"0 0 * * 7":           at(2018, time.July, 15, 0, 0, 0),
+		"@daily":              at(2018, time.July, 14, 0, 0, 0),
+		"@hourly":             at(2018, time.July, 13, 11, 0, 0),
+		"@weekly":             at(2018, time.July, 15, 0, 0, 0),
+		"@monthly":            at(2018, time.August, 1, 0, 0, 0),
+		"@yearly":             at(2019, time.January, 1, 0, 0, 0),
+		"@every 1h30m":        at(2018, time.July, 13, 11, 45, 30),
+		"15 10 13 7 *":        at(2019, time.July, 13, 10, 15, 0),
+		"30 15 10 13 7 *":     at(2019, time.July, 13, 10, 15, 30),
+		"0 0 0 30 2 *":        {},
+		"0 0 9-17/4 * * *":    at(2018, time.July, 13, 13, 0, 0),
+		"0 0 9 ? JAN,JUL MON": at(2018, time.July, 16, 9, 0, 0),
+	}
+	for spec, expected := range next {
+		spec, expected := spec, expected
+		It("should find the next time "+spec+" fires", func() {
+			Expect(MustParse(spec).Next(now)).To(Equal(expected))
+		})
+	}
+
+	It("should include Sunday at the end of a range", func() {
+		for _, spec := range []string{"0 0 * * FRI-SUN", "0 0 * * 5-0", "0 0 * * 5-7"} {
+			s := MustParse(spec)
+			Expect(s.Next(at(2018, time.July, 14, 0, 0, 0))).To(Equal(at(2018, time.July, 15, 0, 0, 0)), spec)
+			Expect(s.Next(at(2018, time.July, 15, 0, 0, 0))).To(Equal(at(2018, time.July, 20, 0, 0, 0)), spec)
+		}
+		Expect(MustParse("0 0 * * SUN-SUN").Next(at(2018, time.July, 15, 0, 0, 0))).
+			To(Equal(at(2018, time.July, 22, 0, 0, 0)))
+	})
+
+	It("should parse @every in any case", func() {
+		Expect(MustParse("@EVERY 1h").Next(now)).To(Equal(MustParse("@every 1h").Next(now)))
+	})
+
+	var prev = map[string]time.Time{
+		"* * * * *":         at(2018, time.July, 13, 10, 15, 0),
+		"30 9 * * *":        at(2018, time.July, 13, 9, 30, 0),
+		"30 11 * * MON-FRI": at(2018, time.July, 12, 11, 30, 0),
+		"0 0 L * *":         at(2018, time.June, 30, 0, 0, 0),
+		"0 0 * * FRIL":      at(2018, time.June, 29, 0, 0, 0),
+		"0 0 29 2 *":        at(2016, time.February, 29, 0, 0, 0),
+		"@every 1h":         at(2018, time.July, 13, 9, 15, 30),
+		"0 0 0 30 2 *":      {},
+	}
+	for spec, expected := range prev {
+		spec, expected := spec, expected
+		It("should find the previous time "+spec+" fired", func() {
+			Expect(MustParse(spec).Prev(now)).To(Equal(expected))
+		})
+	}
+
+	It("should fire on days matching either the day of month or the day of week", func() {
+		s := MustParse("0 0 1,15 * MON")
+		Expect(s.Next(now)).To(Equal(at(2018, time.July, 15, 0, 0, 0)))
+		Expect(s.Next(at(2018, time.July, 15, 0, 0, 0))).To(Equal(at(2018, time.July, 16, 0, 0, 0)))
+	})
+
+	It("should evaluate the schedule in its Location", func() {
+		s := MustParse("0 9 * * *")
+		s.Location = newYork
+		Expect(s.Next(time.Date(2018, time.July, 13, 12, 0, 0, 0, time.UTC))).
+			To(Equal(at(2018, time.July, 13, 9, 0, 0).In(newYork)))
+		Expect(s.Next(time.Date(2018, time.July, 13, 14, 0, 0, 0, time.UTC))).
+			To(Equal(at(2018, time.July, 14, 9, 0, 0)))
+	})
+
+	It("should keep the wall clock time across daylight savings time", func() {
+		s := MustParse("30 9 * * *")
+		Expect(s.Next(at(2018, time.March, 10, 10, 0, 0))).To(Equal(at(2018, time.March, 11, 9, 30, 0)))
+		Expect(s.Next(at(2018, time.November, 3, 10, 0, 0))).To(Equal(at(2018, time.November, 4, 9, 30, 0)))
+	})
+
+	It("should fire times skipped by daylight savings time when the clocks change", func() {
+		s := MustParse("30 2 * * *")
+		change := time.Date(2018, time.March, 11, 7, 0, 0, 0, time.UTC).In(newYork) // 3am EDT
+		Expect(s.Next(at(2018, time.March, 11, 0, 0, 0))).To(BeTemporally("==", change))
+		Expect(s.Next(change)).To(Equal(at(2018, time.March, 12, 2, 30, 0)))
+		Expect(s.Prev(at(2018, time.March, 11, 4, 0, 0))).To(BeTemporally("==", change))
+
+		every := MustParse("*/15 * * * *")
+		Expect(every.Next(at(2018, time.March, 11, 1, 50, 0))).To(BeTemporally("==", change))
+		Expect(every.Next(change)).To(BeTemporally("==", change.Add(15*time.Minute)))
+	})
+
+	It("should fire times repeated by daylight savings time once", func() {
+		s := MustParse("30 1 * * *")
+		first := time.Date(2018, time.November, 4, 5, 30, 0, 0, time.UTC).In(newYork) // 1:30am EDT
+		Expect(s.Next(at(2018, time.November, 4, 0, 0, 0))).To(BeTemporally("==", first))
+		Expect(s.Next(first)).To(Equal(at(2018, time.November, 5, 1, 30, 0)))
+
+		// An hour later it is 1:30am again, and the last time was the first time around
+		Expect(s.Prev(first.Add(90 * time.Minute))).To(BeTemporally("==", first))
+	})
+
+	It("should skip holidays", func() {
+		s := MustParse("0 9 * * MON-FRI")
+		s.Holidays = holiday.TradingHolidays
+		// July 4th, 2018 was a Wednesday
+		Expect(s.Next(at(2018, time.July, 3, 10, 0, 0))).To(Equal(at(2018, time.July, 5, 9, 0, 0)))
+		Expect(s.Prev(at(2018, time.July, 5, 8, 0, 0))).To(Equal(at(2018, time.July, 3, 9, 0, 0)))
+
+		every := MustParse("@every 24h")
+		every.Holidays = holiday.TradingHolidays
+		Expect(every.Next(at(2018, time.July, 3, 10, 0, 0))).To(Equal(at(2018, time.July, 5, 10, 0, 0)))
+	})
+})
@@ -0,0 +1,304 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/onwsk8r/gotime"
+)
+
+// descriptors are the predefined schedules, in six-field format.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// bounds describes the values a field may have.
+type bounds struct {
+	name     string
+	min, max int
+	names    []string // The names of the values starting from min, if they have any
+}
+
+var (
+	seconds  = bounds{"second", 0, 59, nil}
+	minutes  = bounds{"minute", 0, 59, nil}
+	hours    = bounds{"hour", 0, 23, nil}
+	days     = bounds{"day of month", 1, 31, nil}
+	months   = bounds{"month", 1, 12, []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	weekdays = bounds{"day of week", 0, 7, []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}}
+)
+
+// Parse parses a cron expression. It accepts five fields (minute, hour, day of
+// month, month, and day of week), six fields with the second first, or one of
+// the descriptors @yearly (or @annually), @monthly, @weekly, @daily (or
+// @midnight), @hourly, and @every <duration>, where the duration is anything
+// time.ParseDuration accepts.
+//
+// Each field is a comma-separated list of values, ranges (1-5), and steps
+// (*/15 or 1-30/2), or * for every value. Months and days of the week may be
+// written as names (JAN, MON); Sunday is 0 or 7, and ? is the same as *.
+// A range of days of the week may end on Sunday, as in FRI-SUN. Descriptors
+// are not case sensitive.
+// The day of month may also be L for the last day of the month, L-n for n days
+// before it, nW for the weekday nearest the nth, or LW for the last weekday.
+// The day of week may also be dL for the last such day of the month, eg 5L or
+// FRIL for the last Friday, or d#n for the nth, eg MON#2 for the second Monday.
+//
+// As in most crons, when both the day of month and the day of week are
+// restricted, the schedule fires on days matching either one.
+func Parse(spec string) (Schedule, error) {
+	s := Schedule{spec: spec}
+	str := strings.TrimSpace(spec)
+	if strings.HasPrefix(strings.ToLower(str), "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(str[7:]))
+		if err != nil || d < time.Second {
+			return Schedule{}, gotime.NewParseError(spec, "@every needs a duration of at least one second")
+		}
+		s.every = d
+		return s, nil
+	}
+	if strings.HasPrefix(str, "@") {
+		var ok bool
+		if str, ok = descriptors[strings.ToLower(str)]; !ok {
+			return Schedule{}, gotime.NewParseError(spec, fmt.Sprintf("Unknown descriptor %s", spec))
+		}
+	}
+
+	fields := strings.Fields(str)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return Schedule{}, gotime.NewParseError(spec, fmt.Sprintf("Should have 5 or 6 fields, not %d", len(fields)))
+	}
+
+	var err error
+	if s.second, err = parseField(fields[0], seconds); err != nil {
+		return Schedule{}, gotime.NewParseError(spec, err.Error())
+	}
+	if s.minute, err = parseField(fields[1], minutes); err != nil {
+		return Schedule{}, gotime.NewParseError(spec, err.Error())
+	}
+	if s.hour, err = parseField(fields[2], hours); err != nil {
+		return Schedule{}, gotime.NewParseError(spec, err.Error())
+	}
+	if s.month, err = parseField(fields[4], months); err != nil {
+		return Schedule{}, gotime.NewParseError(spec, err.Error())
+	}
+	if err = s.parseDays(fields[3], fields[5]); err != nil {
+		return Schedule{}, gotime.NewParseError(spec, err.Error())
+	}
+	return s, nil
+}
+
+// parseDays parses the day of month and day of week fields.
+func (s *Schedule) parseDays(dom, dow string) error {
+	s.domStar = dom == "*" || dom == "?"
+	s.dowStar = dow == "*" || dow == "?"
+
+	var plain []string
+	for _, part := range strings.Split(strings.ToUpper(dom), ",") {
+		matcher, err := parseDayOfMonth(part)
+		if err != nil {
+			return err
+		}
+		if matcher == nil {
+			plain = append(plain, part)
+		} else {
+			s.domSpecial = append(s.domSpecial, matcher)
+		}
+	}
+	var err error
+	if s.dom, err = parseField(strings.Join(plain, ","), days); err != nil {
+		return err
+	}
+
+	plain = nil
+	for _, part := range strings.Split(strings.ToUpper(dow), ",") {
+		matcher, err := parseDayOfWeek(part)
+		if err != nil {
+			return err
+		}
+		if matcher == nil {
+			plain = append(plain, part)
+		} else {
+			s.dowSpecial = append(s.dowSpecial, matcher)
+		}
+	}
+	if s.dow, err = parseField(strings.Join(plain, ","), weekdays); err != nil {
+		return err
+	}
+	if s.dow&(1<<7) != 0 { // Sunday can be 7
+		s.dow |= 1
+	}
+	return nil
+}
+
+// dayMatcher determines whether a date matches an L, W, or # extension.
+type dayMatcher func(year int, month time.Month, day int) bool
+
+// parseDayOfMonth parses an L or W extension in the day of month field.
+// It returns nil if the part has neither.
+func parseDayOfMonth(part string) (dayMatcher, error) {
+	switch {
+	case part == "L":
+		return func(y int, m time.Month, d int) bool { return d == lastDay(y, m) }, nil
+	case part == "LW":
+		return func(y int, m time.Month, d int) bool {
+			last := lastDay(y, m)
+			return d == nearestWeekday(y, m, last, last)
+		}, nil
+	case strings.HasPrefix(part, "L-"):
+		n, err := parseValue(part[2:], bounds{"day of month offset", 0, 30, nil})
+		if err != nil {
+			return nil, err
+		}
+		return func(y int, m time.Month, d int) bool { return d == lastDay(y, m)-n }, nil
+	case strings.HasSuffix(part, "W"):
+		n, err := parseValue(strings.TrimSuffix(part, "W"), days)
+		if err != nil {
+			return nil, err
+		}
+		return func(y int, m time.Month, d int) bool {
+			last := lastDay(y, m)
+			return n <= last && d == nearestWeekday(y, m, n, last)
+		}, nil
+	}
+	return nil, nil
+}
+
+// parseDayOfWeek parses an L or # extension in the day of week field.
+// It returns nil if the part has neither.
+func parseDayOfWeek(part string) (dayMatcher, error) {
+	switch {
+	case strings.Contains(part, "#"):
+		sides := strings.SplitN(part, "#", 2)
+		wd, err := parseValue(sides[0], weekdays)
+		if err != nil {
+			return nil, err
+		}
+		n, err := parseValue(sides[1], bounds{"week of month", 1, 5, nil})
+		if err != nil {
+			return nil, err
+		}
+		return func(y int, m time.Month, d int) bool {
+			t := gotime.NthWeekday(y, m, n, time.Weekday(wd%7))
+			return t.Month() == m && t.Day() == d
+		}, nil
+	case len(part) > 1 && strings.HasSuffix(part, "L"):
+		wd, err := parseValue(strings.TrimSuffix(part, "L"), weekdays)
+		if err != nil {
+			return nil, err
+		}
+		return func(y int, m time.Month, d int) bool {
+			return gotime.LastWeekday(y, m, time.Weekday(wd%7)).Day() == d
+		}, nil
+	}
+	return nil, nil
+}
+
+// parseField parses a comma-separated list of values, ranges, and steps into
+// a bit set with a bit for each value in the field. An empty field has no bits.
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	if field == "" {
+		return 0, nil
+	}
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			var err error
+			rng = part[:idx]
+			if step, err = strconv.Atoi(part[idx+1:]); err != nil || step < 1 {
+				return 0, errors.Errorf("'%s' is not a valid step", part[idx+1:])
+			}
+		}
+
+		lo, hi := b.min, b.max
+		switch {
+		case rng == "*" || rng == "?":
+			if b.name == "day of week" {
+				hi = 6 // Sunday is already 0
+			}
+		case strings.Contains(rng, "-"):
+			sides := strings.SplitN(rng, "-", 2)
+			var err error
+			if lo, err = parseValue(sides[0], b); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(sides[1], b); err != nil {
+				return 0, err
+			}
+			if b.name == "day of week" && hi == 0 && lo > 0 {
+				hi = 7 // A range can end on Sunday, eg FRI-SUN
+			}
+			if hi < lo {
+				return 0, errors.Errorf("%s range %s ends before it starts", b.name, rng)
+			}
+		default:
+			var err error
+			if lo, err = parseValue(rng, b); err != nil {
+				return 0, err
+			}
+			if step == 1 {
+				hi = lo
+			}
+		}
+
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+// parseValue parses a single number or name in a field.
+func parseValue(str string, b bounds) (int, error) {
+	for i, name := range b.names {
+		if strings.EqualFold(str, name) {
+			return b.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(str)
+	if err != nil {
+		return 0, errors.Errorf("'%s' is not a valid %s", str, b.name)
+	}
+	if n < b.min || n > b.max {
+		return 0, errors.Errorf("%s %d is out of range [%d-%d]", b.name, n, b.min, b.max)
+	}
+	return n, nil
+}
+
+// lastDay returns the number of days in a month.
+func lastDay(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekday returns the weekday nearest the nth of a month without
+// leaving the month, which has <last> days: a Saturday moves to Friday unless
+// it is the 1st, and a Sunday moves to Monday unless it is the last day.
+func nearestWeekday(year int, month time.Month, n, last int) int {
+	switch time.Date(year, month, n, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if n == 1 {
+			return 3
+		}
+		return n - 1
+	case time.Sunday:
+		if n == last {
+			return n - 2
+		}
+		return n + 1
+	}
+	return n
+}
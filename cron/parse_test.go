@@ -0,0 +1,63 @@
+package cron_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onwsk8r/gotime"
+	. "github.com/onwsk8r/gotime/cron"
+)
+
+var _ = Describe("Parse", func() {
+	It("should accept five and six fields and descriptors", func() {
+		for _, spec := range []string{
+			"* * * * *",
+			"*/5 * * * * *",
+			"0 9-17/2 * JAN-MAR,DEC MON-FRI",
+			"0 0 L * ?",
+			"0 0 L-3,15W,LW * *",
+			"0 0 ? * FRIL,MON#2,7",
+			"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly",
+			"@every 1h30m", "@EVERY 1m", "@Daily",
+			"0 0 * * FRI-SUN", "0 0 * * 5-0",
+		} {
+			s, err := Parse(spec)
+			Expect(err).ToNot(HaveOccurred(), spec)
+			Expect(s.String()).To(Equal(spec))
+		}
+	})
+
+	var problems = map[string]string{
+		"* * * *":         "Should have 5 or 6 fields, not 4",
+		"@fortnightly":    "Unknown descriptor @fortnightly",
+		"@every 10ms":     "@every needs a duration of at least one second",
+		"@every never":    "@every needs a duration of at least one second",
+		"60 * * * *":      "minute 60 is out of range [0-59]",
+		"* 24 * * *":      "hour 24 is out of range [0-23]",
+		"* * 0 * *":       "day of month 0 is out of range [1-31]",
+		"* * * 13 *":      "month 13 is out of range [1-12]",
+		"* * * * 8":       "day of week 8 is out of range [0-7]",
+		"* * * FOO *":     "'FOO' is not a valid month",
+		"* * * * */0":     "'0' is not a valid step",
+		"* 5-3 * * *":     "hour range 5-3 ends before it starts",
+		"* * * * MON#6":   "week of month 6 is out of range [1-5]",
+		"* * 32W * *":     "day of month 32 is out of range [1-31]",
+		"60 * * * * *":    "second 60 is out of range [0-59]",
+		"* * L-31 * *":    "day of month offset 31 is out of range [0-30]",
+		"* * * * BLAHL":   "'BLAH' is not a valid day of week",
+		"0 0 0 * * * * *": "Should have 5 or 6 fields, not 8",
+	}
+	for spec, problem := range problems {
+		spec, problem := spec, problem
+		It("should reject "+spec, func() {
+			_, err := Parse(spec)
+			Expect(err).To(BeAssignableToTypeOf(&gotime.ParseError{}))
+			Expect(err.(*gotime.ParseError).Problem).To(Equal(problem))
+		})
+	}
+
+	It("should panic in MustParse", func() {
+		Expect(func() { MustParse("bogus") }).To(Panic())
+		Expect(func() { MustParse("@daily") }).ToNot(Panic())
+	})
+})
@@ -0,0 +1,179 @@
+package gotime
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Date represents a calendar date without a time or a time zone, such as a
+// birthday. Unlike a time.Time at midnight, a Date does not change when it
+// is moved between time zones. The zero value is not a valid date; use
+// IsValid to check for it.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the Date of a time.Time in that time's location.
+func DateOf(t time.Time) Date {
+	var d Date
+	d.Year, d.Month, d.Day = t.Date()
+	return d
+}
+
+// ParseDate parses a date using Parse, so it accepts any of the formats
+// supported by DateParser. If the string includes a time, the date is taken
+// as written, ignoring the time and any offset.
+func ParseDate(str string) (Date, error) {
+	t, err := Parse(str)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// String returns the date in YYYY-MM-DD format.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsValid determines whether the date exists, eg it is not February 30th.
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+// In returns a time.Time at midnight of the date in the given location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// At returns a time.Time at the given clock time on the date in the given location.
+func (d Date) At(c Clock, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, c.Hour, c.Minute, c.Second, c.Nanosecond, loc)
+}
+
+// Weekday returns the day of the week of the date.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// YearDay returns the day of the year of the date, from 1 to 366.
+func (d Date) YearDay() int {
+	return d.In(time.UTC).YearDay()
+}
+
+// AddDays returns the date n days after d. n may be negative.
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+// AddDate returns the date that is the given number of years, months, and days
+// after d. Like time.Time.AddDate, it normalizes its result: October 31st plus
+// one month is December 1st.
+func (d Date) AddDate(years, months, days int) Date {
+	return DateOf(d.In(time.UTC).AddDate(years, months, days))
+}
+
+// Before determines whether d is before other.
+func (d Date) Before(other Date) bool {
+	return DaysBetween(d, other) > 0
+}
+
+// After determines whether d is after other.
+func (d Date) After(other Date) bool {
+	return DaysBetween(d, other) < 0
+}
+
+// Equal determines whether d and other are the same date.
+func (d Date) Equal(other Date) bool {
+	return DaysBetween(d, other) == 0
+}
+
+// Compare returns -1 if d is before other, 0 if they are equal, and 1 if d is after other.
+func (d Date) Compare(other Date) int {
+	switch n := DaysBetween(d, other); {
+	case n > 0:
+		return -1
+	case n < 0:
+		return 1
+	}
+	return 0
+}
+
+// DaysBetween returns the number of days from a to b. The result is
+// negative if b is before a.
+func DaysBetween(a, b Date) int {
+	return int((b.In(time.UTC).Unix() - a.In(time.UTC).Unix()) / (24 * 60 * 60))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The date is in YYYY-MM-DD format, and the zero Date is an empty string.
+func (d Date) MarshalText() ([]byte, error) {
+	if d == (Date{}) {
+		return []byte{}, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// An empty string results in the zero Date.
+func (d *Date) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	res, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The zero Date is marshaled as null.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d == (Date{}) {
+		return []byte("null"), nil
+	}
+	return []byte(fmt.Sprintf("\"%s\"", d)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both null and an empty string result in the zero Date.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = Date{}
+		return nil
+	}
+	return d.UnmarshalText([]byte(strings.Trim(string(data), "\"")))
+}
+
+// Value implements the driver.Valuer interface for use with DATE columns.
+// The date is passed to the driver as a string in YYYY-MM-DD format, so that
+// a driver that converts times to its own location cannot change the day.
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts a time.Time, in which
+// case the date is taken in the time's location, or a string or byte slice.
+func (d *Date) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case time.Time:
+		*d = DateOf(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return errors.Errorf("Error converting %T to date", value)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package gotime_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime"
+)
+
+var _ = Describe("Date", func() {
+	var ref Date
+
+	BeforeEach(func() {
+		ref = Date{2018, time.July, 14}
+	})
+
+	It("should parse any supported date format", func() {
+		for _, str := range []string{"2018-07-14", "20180714", "2018-195", "2018-W28-6",
+			"2018-07-14T23:30:00-05:00"} {
+			Expect(ParseDate(str)).To(Equal(ref), str)
+		}
+		_, err := ParseDate("2018-02-30")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not shift between time zones", func() {
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(DateOf(ref.In(tokyo))).To(Equal(ref))
+		Expect(DateOf(ref.In(time.Local))).To(Equal(ref))
+	})
+
+	It("should validate dates", func() {
+		Expect(ref.IsValid()).To(BeTrue())
+		Expect(Date{2018, time.February, 29}.IsValid()).To(BeFalse())
+		Expect(Date{}.IsValid()).To(BeFalse())
+	})
+
+	It("should combine with a Clock", func() {
+		exp := time.Date(2018, time.July, 14, 9, 30, 0, 0, time.UTC)
+		Expect(ref.At(Clock{Hour: 9, Minute: 30}, time.UTC)).To(Equal(exp))
+	})
+
+	Describe("arithmetic", func() {
+		It("should add days across months and years", func() {
+			Expect(ref.AddDays(18)).To(Equal(Date{2018, time.August, 1}))
+			Expect(ref.AddDays(-195)).To(Equal(Date{2017, time.December, 31}))
+			Expect(ref.AddDate(0, 1, 0)).To(Equal(Date{2018, time.August, 14}))
+		})
+
+		It("should count the days between dates", func() {
+			Expect(DaysBetween(ref, Date{2019, time.July, 14})).To(Equal(365))
+			Expect(DaysBetween(ref, Date{2018, time.July, 1})).To(Equal(-13))
+			Expect(DaysBetween(Date{1600, time.January, 1}, Date{2400, time.January, 1})).To(Equal(292194))
+		})
+
+		It("should compare dates", func() {
+			later := ref.AddDays(1)
+			Expect(ref.Before(later)).To(BeTrue())
+			Expect(later.After(ref)).To(BeTrue())
+			Expect(ref.Equal(later.AddDays(-1))).To(BeTrue())
+			Expect(ref.Compare(later)).To(Equal(-1))
+			Expect(later.Compare(ref)).To(Equal(1))
+			Expect(ref.Compare(ref)).To(Equal(0))
+		})
+
+		It("should find the day of the week and year", func() {
+			Expect(ref.Weekday()).To(Equal(time.Saturday))
+			Expect(ref.YearDay()).To(Equal(195))
+		})
+	})
+
+	Describe("encoding", func() {
+		type row struct {
+			Birthday Date
+		}
+
+		It("should round trip JSON", func() {
+			var v row
+			in := `{"Birthday":"2018-07-14"}`
+			Expect(json.Unmarshal([]byte(in), &v)).To(Succeed())
+			Expect(v.Birthday).To(Equal(ref))
+			Expect(json.Marshal(v)).To(Equal([]byte(in)))
+		})
+
+		It("should marshal the zero Date as null", func() {
+			var v row
+			Expect(json.Marshal(v)).To(Equal([]byte(`{"Birthday":null}`)))
+			Expect(json.Unmarshal([]byte(`{"Birthday":null}`), &v)).To(Succeed())
+			Expect(v.Birthday).To(BeZero())
+		})
+
+		It("should scan and value SQL dates", func() {
+			var d Date
+			Expect(d.Scan(time.Date(2018, time.July, 14, 0, 0, 0, 0, time.UTC))).To(Succeed())
+			Expect(d).To(Equal(ref))
+			Expect(d.Scan([]byte("2018-07-14"))).To(Succeed())
+			Expect(d).To(Equal(ref))
+			Expect(d.Value()).To(Equal("2018-07-14"))
+			v, err := d.Value()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(driver.IsValue(v)).To(BeTrue())
+			Expect(d.Scan(42)).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,136 @@
+/*
+Package daycount implements the day count conventions used to calculate
+interest accrued between two dates.
+
+Each Convention counts the days between two dates and converts them to a year
+fraction, which multiplied by an annual rate gives the interest accrued. The
+conventions are the ones in the 2006 ISDA Definitions, plus the ICMA rule
+for bonds and Business/252 for the Brazilian market:
+
+	Actual360, Actual365Fixed    Actual days over a fixed year
+	ActualActualISDA             Actual days over the length of each year
+	ActualActualICMA             Actual days over the length of the coupon period
+	Thirty360BondBasis, ...      Months of 30 days and years of 360
+	Business252                  Business days over 252
+
+Only the dates of the times passed to a Convention matter: the times of day
+and locations are ignored.
+*/
+package daycount
+
+import (
+	"time"
+
+	"github.com/onwsk8r/gotime/holiday"
+)
+
+// Convention is a day count convention.
+type Convention interface {
+	// DayCount returns the number of days from start to end under the convention.
+	DayCount(start, end time.Time) int
+	// YearFraction returns the number of years from start to end under the convention.
+	YearFraction(start, end time.Time) float64
+	// String returns the name of the convention, eg Actual/360.
+	String() string
+}
+
+// actual divides the actual number of days by a fixed number of days in a year.
+type actual struct {
+	name string
+	days float64
+}
+
+var (
+	// Actual360 is Actual/360, used for most money market instruments.
+	Actual360 Convention = actual{"Actual/360", 360}
+	// Actual365Fixed is Actual/365 (Fixed), used for sterling money markets.
+	Actual365Fixed Convention = actual{"Actual/365 Fixed", 365}
+)
+
+func (a actual) DayCount(start, end time.Time) int {
+	return days(start, end)
+}
+
+func (a actual) YearFraction(start, end time.Time) float64 {
+	return float64(days(start, end)) / a.days
+}
+
+func (a actual) String() string {
+	return a.name
+}
+
+// actualISDA divides the days in each calendar year by the length of that year.
+type actualISDA struct{}
+
+// ActualActualISDA is Actual/Actual (ISDA): the days falling in a leap year
+// are divided by 366 and the rest by 365.
+var ActualActualISDA Convention = actualISDA{}
+
+func (actualISDA) DayCount(start, end time.Time) int {
+	return days(start, end)
+}
+
+func (a actualISDA) YearFraction(start, end time.Time) float64 {
+	if end.Before(start) {
+		return -a.YearFraction(end, start)
+	}
+
+	start, end = date(start), date(end)
+	res := 0.0
+	for y := start.Year(); y <= end.Year(); y++ {
+		from, to := newYear(y), newYear(y+1)
+		length := float64(days(from, to))
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+		res += float64(days(from, to)) / length
+	}
+	return res
+}
+
+func (actualISDA) String() string {
+	return "Actual/Actual ISDA"
+}
+
+// business252 divides the business days by 252.
+type business252 struct {
+	calendar holiday.Calendar
+}
+
+// Business252 returns the Business/252 convention, which counts the business
+// days after the start up to and including the end on a calendar with the
+// given holidays and a Saturday and Sunday weekend.
+func Business252(holidays holiday.List) Convention {
+	return business252{holiday.NewCalendar(holidays)}
+}
+
+func (b business252) DayCount(start, end time.Time) int {
+	return b.calendar.BusinessDaysBetween(start, end)
+}
+
+func (b business252) YearFraction(start, end time.Time) float64 {
+	return float64(b.DayCount(start, end)) / 252
+}
+
+func (business252) String() string {
+	return "Business/252"
+}
+
+// date returns midnight UTC on the date of t.
+func date(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// newYear returns midnight UTC on January 1st of a year.
+func newYear(year int) time.Time {
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// days returns the actual number of days from the date of start to the date of end.
+func days(start, end time.Time) int {
+	return int(date(end).Sub(date(start)) / (24 * time.Hour))
+}
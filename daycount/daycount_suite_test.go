@@ -0,0 +1,13 @@
+package daycount_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDaycount(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Daycount Suite")
+}
@@ -0,0 +1,133 @@
+package daycount_test
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/daycount"
+	"github.com/onwsk8r/gotime/holiday"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+var _ = Describe("Convention", func() {
+	// The example from the ISDA's EMU and Market Conventions paper
+	start, end := date(2003, time.November, 1), date(2004, time.May, 1)
+
+	It("should count actual days", func() {
+		Expect(Actual360.DayCount(start, end)).To(Equal(182))
+		Expect(Actual360.YearFraction(start, end)).To(BeNumerically("~", 182.0/360, 1e-12))
+		Expect(Actual365Fixed.YearFraction(start, end)).To(BeNumerically("~", 182.0/365, 1e-12))
+		Expect(Actual360.YearFraction(end, start)).To(BeNumerically("~", -182.0/360, 1e-12))
+	})
+
+	It("should ignore the time of day", func() {
+		late := time.Date(2003, time.November, 1, 23, 0, 0, 0, time.UTC)
+		early := time.Date(2004, time.May, 1, 1, 0, 0, 0, time.FixedZone("", -5*60*60))
+		Expect(Actual360.DayCount(late, early)).To(Equal(182))
+	})
+
+	It("should split Actual/Actual ISDA by year", func() {
+		Expect(ActualActualISDA.YearFraction(start, end)).To(BeNumerically("~", 61.0/365+121.0/366, 1e-12))
+		Expect(ActualActualISDA.YearFraction(date(2004, time.January, 1), date(2006, time.January, 1))).
+			To(BeNumerically("~", 2, 1e-12))
+		Expect(ActualActualISDA.YearFraction(end, start)).To(BeNumerically("~", -(61.0/365 + 121.0/366), 1e-12))
+	})
+
+	Describe("Actual/Actual ICMA", func() {
+		It("should accrue over a regular period", func() {
+			Expect(ActualActualICMA{Frequency: 2}.YearFraction(start, end)).To(BeNumerically("~", 0.5, 1e-12))
+		})
+
+		It("should accrue part of a reference period", func() {
+			c := ActualActualICMA{Frequency: 2, RefStart: start, RefEnd: end}
+			Expect(c.YearFraction(start, date(2004, time.February, 1))).To(BeNumerically("~", 92.0/364, 1e-12))
+		})
+
+		It("should accrue a short first stub", func() {
+			c := ActualActualICMA{Frequency: 1, RefStart: date(1998, time.July, 1), RefEnd: date(1999, time.July, 1)}
+			Expect(c.YearFraction(date(1999, time.February, 1), date(1999, time.July, 1))).
+				To(BeNumerically("~", 150.0/365, 1e-12))
+		})
+
+		It("should accrue a long first stub", func() {
+			c := ActualActualICMA{Frequency: 1, RefStart: date(1999, time.July, 1), RefEnd: date(2000, time.July, 1)}
+			Expect(c.YearFraction(date(1999, time.February, 1), date(2000, time.July, 1))).
+				To(BeNumerically("~", 150.0/365+1, 1e-12))
+		})
+
+		It("should accrue a long last stub", func() {
+			c := ActualActualICMA{Frequency: 2, RefStart: date(2000, time.January, 15), RefEnd: date(2000, time.July, 15)}
+			Expect(c.YearFraction(date(2000, time.January, 15), date(2000, time.September, 15))).
+				To(BeNumerically("~", 0.5+62.0/(2*184), 1e-12))
+		})
+
+		It("should reject frequencies that do not divide the year", func() {
+			for _, f := range []int{0, -2, 5, 24} {
+				c := ActualActualICMA{Frequency: f}
+				Expect(c.Validate()).To(HaveOccurred())
+				Expect(math.IsNaN(c.YearFraction(start, end))).To(BeTrue())
+			}
+			for _, f := range []int{1, 2, 3, 4, 6, 12} {
+				Expect(ActualActualICMA{Frequency: f}.Validate()).NotTo(HaveOccurred())
+			}
+		})
+	})
+
+	Describe("30/360", func() {
+		var examples = []struct {
+			start, end                 time.Time
+			bond, us, european, german int
+		}{
+			{date(2007, time.January, 15), date(2007, time.January, 30), 15, 15, 15, 15},
+			{date(2007, time.January, 15), date(2007, time.February, 15), 30, 30, 30, 30},
+			{date(2007, time.February, 28), date(2007, time.March, 31), 33, 30, 32, 30},
+			{date(2007, time.January, 31), date(2007, time.February, 28), 28, 28, 28, 30},
+			{date(2007, time.January, 31), date(2007, time.March, 31), 60, 60, 60, 60},
+			{date(2007, time.February, 28), date(2008, time.February, 29), 361, 360, 361, 360},
+			{date(2008, time.February, 29), date(2009, time.February, 28), 359, 360, 359, 360},
+			{date(2007, time.January, 15), date(2007, time.March, 31), 76, 76, 75, 75},
+		}
+		for _, ex := range examples {
+			ex := ex
+			It("should count "+ex.start.Format("2006-01-02")+" to "+ex.end.Format("2006-01-02"), func() {
+				Expect(Thirty360BondBasis.DayCount(ex.start, ex.end)).To(Equal(ex.bond), "Bond Basis")
+				Expect(Thirty360US.DayCount(ex.start, ex.end)).To(Equal(ex.us), "US")
+				Expect(Thirty360European.DayCount(ex.start, ex.end)).To(Equal(ex.european), "European")
+				Expect(Thirty360German.DayCount(ex.start, ex.end)).To(Equal(ex.german), "German")
+				Expect(Thirty360German.YearFraction(ex.start, ex.end)).To(BeNumerically("~", float64(ex.german)/360, 1e-12))
+			})
+		}
+	})
+
+	It("should count business days", func() {
+		c := Business252(holiday.TradingHolidays)
+		// July 4th, 2018 was a Wednesday
+		Expect(c.DayCount(date(2018, time.July, 2), date(2018, time.July, 9))).To(Equal(4))
+		Expect(c.YearFraction(date(2018, time.July, 2), date(2018, time.July, 9))).To(BeNumerically("~", 4.0/252, 1e-12))
+	})
+
+	It("should have names", func() {
+		for _, c := range []struct {
+			Convention
+			name string
+		}{
+			{Actual360, "Actual/360"},
+			{Actual365Fixed, "Actual/365 Fixed"},
+			{ActualActualISDA, "Actual/Actual ISDA"},
+			{ActualActualICMA{Frequency: 2}, "Actual/Actual ICMA"},
+			{Thirty360BondBasis, "30/360 Bond Basis"},
+			{Thirty360US, "30/360 US"},
+			{Thirty360European, "30E/360"},
+			{Thirty360German, "30E/360 ISDA"},
+			{Business252(nil), "Business/252"},
+		} {
+			Expect(c.String()).To(Equal(c.name))
+		}
+	})
+})
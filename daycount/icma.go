@@ -0,0 +1,85 @@
+package daycount
+
+import (
+	"math"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/onwsk8r/gotime"
+)
+
+// ActualActualICMA is Actual/Actual (ICMA), the convention for most government
+// and corporate bonds: the days in a coupon period are divided by the length
+// of the period times the number of periods in a year.
+//
+// Frequency is the number of coupons per year: 1, 2, 3, 4, 6, or 12 (see
+// Validate). The reference period is the regular coupon period the dates
+// belong to; if it is zero, the dates are taken to be a regular coupon
+// period themselves. Dates outside the reference
+// period are accrued over notional periods of the same length before or after
+// it, which handles long and short stubs.
+type ActualActualICMA struct {
+	Frequency        int
+	RefStart, RefEnd time.Time
+}
+
+// DayCount returns the actual number of days from start to end.
+func (a ActualActualICMA) DayCount(start, end time.Time) int {
+	return days(start, end)
+}
+
+// Validate returns an error if the Frequency is not one that divides the
+// year into whole months.
+func (a ActualActualICMA) Validate() error {
+	if a.Frequency < 1 || a.Frequency > 12 || 12%a.Frequency != 0 {
+		return errors.Errorf("Invalid ICMA frequency %d: must be 1, 2, 3, 4, 6, or 12", a.Frequency)
+	}
+	return nil
+}
+
+// YearFraction returns the number of years from start to end.
+// It returns NaN if the convention is not valid.
+func (a ActualActualICMA) YearFraction(start, end time.Time) float64 {
+	if a.Validate() != nil {
+		return math.NaN()
+	}
+	if end.Before(start) {
+		return -a.YearFraction(end, start)
+	}
+	refStart, refEnd := a.RefStart, a.RefEnd
+	if refStart.IsZero() || refEnd.IsZero() {
+		refStart, refEnd = start, end
+	}
+	return a.fraction(date(start), date(end), date(refStart), date(refEnd))
+}
+
+// fraction accrues from start to end, splitting the dates into notional
+// periods when they extend beyond the reference period.
+func (a ActualActualICMA) fraction(start, end, refStart, refEnd time.Time) float64 {
+	months := 12 / a.Frequency
+	switch {
+	case !start.Before(end):
+		return 0
+	case start.Before(refStart):
+		prev := gotime.AddMonths(refStart, -months)
+		before := end
+		if refStart.Before(before) {
+			before = refStart
+		}
+		return a.fraction(start, before, prev, refStart) + a.fraction(before, end, refStart, refEnd)
+	case end.After(refEnd):
+		next := gotime.AddMonths(refEnd, months)
+		after := start
+		if refEnd.After(after) {
+			after = refEnd
+		}
+		return a.fraction(start, after, refStart, refEnd) + a.fraction(after, end, refEnd, next)
+	}
+	return float64(days(start, end)) / float64(a.Frequency*days(refStart, refEnd))
+}
+
+// String returns the name of the convention.
+func (a ActualActualICMA) String() string {
+	return "Actual/Actual ICMA"
+}
@@ -0,0 +1,96 @@
+package daycount
+
+import (
+	"time"
+)
+
+// thirty360 counts months of 30 days, adjusting the days of the month first.
+type thirty360 struct {
+	name    string
+	variant int
+}
+
+const (
+	bondBasis = iota
+	us
+	european
+	german
+)
+
+var (
+	// Thirty360BondBasis is 30/360 (Bond Basis), aka 30/360 ISDA: a 31st is
+	// treated as the 30th, except that an end on the 31st is only adjusted if
+	// the start was adjusted or is the 30th.
+	Thirty360BondBasis Convention = thirty360{"30/360 Bond Basis", bondBasis}
+
+	// Thirty360US is 30/360 US, aka 30/360 SIA: Bond Basis, plus a start on
+	// the last day of February is treated as the 30th, as is an end on the last
+	// day of February when the start is also the last day of February.
+	Thirty360US Convention = thirty360{"30/360 US", us}
+
+	// Thirty360European is 30E/360, aka Eurobond Basis: every 31st is treated as the 30th.
+	Thirty360European Convention = thirty360{"30E/360", european}
+
+	// Thirty360German is 30E/360 ISDA, aka 30/360 German: the last day of every
+	// month, including February, is treated as the 30th. ISDA makes an exception
+	// for an end on the last day of February that is the maturity date; this
+	// convention does not know the maturity, so use Thirty360European for the
+	// final period of such an instrument.
+	Thirty360German Convention = thirty360{"30E/360 ISDA", german}
+)
+
+func (t thirty360) DayCount(start, end time.Time) int {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+
+	switch t.variant {
+	case us:
+		if isLastOfFebruary(start) {
+			if isLastOfFebruary(end) {
+				d2 = 30
+			}
+			d1 = 30
+		}
+		fallthrough
+	case bondBasis:
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+		if d1 == 31 {
+			d1 = 30
+		}
+	case european:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 {
+			d2 = 30
+		}
+	case german:
+		if isLastOfMonth(start) {
+			d1 = 30
+		}
+		if isLastOfMonth(end) {
+			d2 = 30
+		}
+	}
+	return 360*(y2-y1) + 30*int(m2-m1) + d2 - d1
+}
+
+func (t thirty360) YearFraction(start, end time.Time) float64 {
+	return float64(t.DayCount(start, end)) / 360
+}
+
+func (t thirty360) String() string {
+	return t.name
+}
+
+// isLastOfMonth determines whether t is the last day of its month.
+func isLastOfMonth(t time.Time) bool {
+	return t.AddDate(0, 0, 1).Day() == 1
+}
+
+// isLastOfFebruary determines whether t is February 28th or, in a leap year, the 29th.
+func isLastOfFebruary(t time.Time) bool {
+	return t.Month() == time.February && isLastOfMonth(t)
+}
@@ -12,11 +12,27 @@ compare part of a time.Time, for example to see if the dates are the same
 irrespective of the time zone or if just the time portion is the same down to
 the second.
 
+The Date and Clock types (date.go and clock.go) represent a calendar date
+without a time and a wall clock time without a date, respectively. Neither
+has a time zone, so a birthday or the time a store opens will not shift when
+the value moves between time zones; convert them to a time.Time with Date.In,
+Date.At, or Clock.On once a location is known.
+
+The Period type (period.go) represents an ISO-8601 duration such as P1Y2M10D.
+It keeps years, months, weeks, and days apart from hours, minutes, and seconds
+so that it can be added to a time.Time the way a calendar would. The Interval
+type (interval.go) is a span of time written as two dates or a date and a Period,
+and the Repeating type (repeating.go) repeats an Interval a number of times.
+
 search.go contains functions for finding the first, last, and nth occurrence of
 a particular day in a month. These are handy, for example, when figuring out the
-date of a holiday such as Thanksgiving in the United States.
+date of a holiday such as Thanksgiving in the United States. For anything more involved,
+such as the last weekday of every month, the rrule package evaluates iCalendar
+recurrence rules.
 
 The word "format" is used herein to mean "a valid first argument to time.Parse()".
+The exceptions are the week date formats (see WeekFormat), which time.Parse
+knows nothing about: Parse converts week dates to calendar dates itself.
 
 The Get{Date,Time}Format functions interrogate their string parameter, which
 should be an ISO-8601-compatible string, to determine the proper parsing
@@ -25,8 +41,9 @@ is that the former assume the input is valid while the latter will error if not.
 The Fast functions are fast because they use as few rudimentary string
 functions as possible to narrow down the possibilities and do no verification
 of the source value. The Safely functions are safer because they use regular
-expressions and determine the type using the "if it quacks like a duck" method,
-however they would not prevent you from passing a time like 99:00
+expressions to determine the type and then check that each component is in
+range, so they will stop you from passing a date like 2018-02-30. When they
+fail they return a ParseError whose Problem names the offending component.
 
 Be sure to pass the entire timestamp to each function. The DateParser and TimeParser
 variables are set to the Fast functions by default, and this library uses those
@@ -56,24 +73,22 @@ type Time struct {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface. The time can be in any format.
+// The format that was detected is stored in OriginalFormat. Both null and an
+// empty string result in the zero time.
 func (t *Time) UnmarshalJSON(data []byte) error {
-	value := strings.Trim(string(data), "\"")
-	if value == "" {
-		t.Time = time.Time{}
+	if string(data) == "null" {
+		t.Time, t.OriginalFormat = time.Time{}, ""
 		return nil
 	}
-
-	tm, err := Parse(value)
-	t.Time = tm
-
-	return err
+	return t.UnmarshalText([]byte(strings.Trim(string(data), "\"")))
 }
 
 // MarshalJSON implements the json.Marshaler interface. The time will be in OriginalFormat if set.
 // If OriginalTime is not set, the function will fall back to time.time.MarshalJSON().
-func (t *Time) MarshalJSON() ([]byte, error) {
+// The zero time is marshaled as null.
+func (t Time) MarshalJSON() ([]byte, error) {
 	if t.IsZero() {
-		return nil, nil
+		return []byte("null"), nil
 	}
 	if t.OriginalFormat != "" {
 		return []byte(fmt.Sprintf("\"%s\"", t.Format(t.OriginalFormat))), nil
@@ -82,29 +97,158 @@ func (t *Time) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface. The time can be in any format.
+// The format that was detected is stored in OriginalFormat.
 func (t *Time) UnmarshalText(data []byte) error {
 	value := string(data)
 	if value == "" {
-		t.Time = time.Time{}
+		t.Time, t.OriginalFormat = time.Time{}, ""
 		return nil
 	}
 
-	tm, err := Parse(value)
-	t.Time = tm
+	tm, format, err := ParseWithFormat(value)
+	t.Time, t.OriginalFormat = tm, format
 
 	return err
 }
 
+// MarshalText implements the encoding.TextMarshaler interface. The time will be in
+// OriginalFormat if set. If OriginalFormat is not set, the function will fall back to
+// time.Time.MarshalText(). The zero time is marshaled as an empty string, which
+// UnmarshalText turns back into the zero time.
+func (t Time) MarshalText() ([]byte, error) {
+	if t.IsZero() {
+		return []byte{}, nil
+	}
+	if t.OriginalFormat != "" {
+		return []byte(t.Format(t.OriginalFormat)), nil
+	}
+	return t.Time.MarshalText()
+}
+
+// AppendText implements the encoding.TextAppender interface. Like GobEncode,
+// it must be defined here so that the embedded time.Time's method is not
+// used in its place, ignoring OriginalFormat.
+func (t Time) AppendText(b []byte) ([]byte, error) {
+	text, err := t.MarshalText()
+	return append(b, text...), err
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The result is the length of the embedded time.Time's binary form,
+// that binary form, and then OriginalFormat.
+func (t Time) MarshalBinary() ([]byte, error) {
+	tm, err := t.Time.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, 1+len(tm)+len(t.OriginalFormat))
+	data = append(data, byte(len(tm)))
+	data = append(data, tm...)
+	return append(data, t.OriginalFormat...), nil
+}
+
+// AppendBinary implements the encoding.BinaryAppender interface.
+func (t Time) AppendBinary(b []byte) ([]byte, error) {
+	data, err := t.MarshalBinary()
+	return append(b, data...), err
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return errors.New("Invalid length")
+	}
+	n := 1 + int(data[0])
+
+	if err := t.Time.UnmarshalBinary(data[1:n]); err != nil {
+		return err
+	}
+	t.OriginalFormat = string(data[n:])
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+// It must be defined here, otherwise gob would use the embedded
+// time.Time's method and OriginalFormat would be lost.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (t *Time) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// Format is like time.Time.Format, but it also understands the week date formats.
+func (t Time) Format(layout string) string {
+	return Format(t.Time, layout)
+}
+
 // Value returns the embedded time.Time for use with SQL queries
 func (t *Time) Value() (driver.Value, error) {
 	return t.Time, nil
 }
 
-// Scan implements the sql.Scanner interface
+// Scan implements the sql.Scanner interface. In addition to time.Time, it
+// accepts strings and byte slices, which are parsed with ParseWithFormat
+// (recording OriginalFormat) after allowing a space between the date and
+// the time (2018-07-14 10:05:00), and integers, which are treated as Unix
+// epochs in units of EpochPrecision. Epochs are converted to UTC.
+// Use NullTime for columns that may be NULL.
 func (t *Time) Scan(value interface{}) error {
-	var ok bool
-	if t.Time, ok = value.(time.Time); !ok {
-		return errors.New("Error converting value to time")
+	switch v := value.(type) {
+	case time.Time:
+		t.Time, t.OriginalFormat = v, ""
+	case string:
+		return t.scanString(v)
+	case []byte:
+		return t.scanString(string(v))
+	case int64:
+		return t.scanEpoch(v)
+	case int:
+		return t.scanEpoch(int64(v))
+	case int32:
+		return t.scanEpoch(int64(v))
+	default:
+		return errors.Errorf("Error converting %T to time", value)
 	}
 	return nil
 }
+
+// EpochPrecision is the unit of the integer epochs accepted by Time.Scan.
+// It must be time.Second, time.Millisecond, time.Microsecond, or time.Nanosecond.
+var EpochPrecision = time.Second
+
+// scanString parses a time from the database. Many databases, such as SQLite
+// and MySQL, separate the date and time with a space rather than a T; the
+// space is kept in the OriginalFormat.
+func (t *Time) scanString(value string) error {
+	spaced := len(value) > 10 && value[10] == ' ' && value[4] == '-' && value[7] == '-'
+	if spaced {
+		value = value[:10] + "T" + value[11:]
+	}
+	tm, format, err := ParseWithFormat(value)
+	if err != nil {
+		return err
+	}
+	if spaced {
+		format = format[:10] + " " + format[11:]
+	}
+	t.Time, t.OriginalFormat = tm, format
+	return nil
+}
+
+func (t *Time) scanEpoch(value int64) error {
+	switch EpochPrecision {
+	case time.Second, time.Millisecond, time.Microsecond, time.Nanosecond:
+	default:
+		return errors.Errorf("Invalid EpochPrecision %s", EpochPrecision)
+	}
+
+	// Split the value into seconds and nanoseconds to avoid overflowing
+	per := int64(time.Second / EpochPrecision)
+	sec, nsec := value/per, value%per*int64(EpochPrecision)
+	t.Time, t.OriginalFormat = time.Unix(sec, nsec).UTC(), ""
+	return nil
+}
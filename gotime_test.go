@@ -0,0 +1,186 @@
+package gotime_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime"
+)
+
+var _ = Describe("Time", func() {
+	var roundTrips = []string{"2018-07-14", "2018-07", "20180714", "--07-14", "--0714",
+		"2018-W28-6", "2018W286", "2018-W28", "2018W28", "2018-195", "2018195",
+		"2018-07-14T10:05:00Z", "2018-07-14T10:05:00.123+05:30", "20180714T100500-0700",
+		"2018-W28-6T10:05:00Z", "2018-195T10:05:00.000000-05:00"}
+
+	Describe("JSON", func() {
+		for _, d := range roundTrips {
+			d := d
+			It(fmt.Sprintf("should round trip %s", d), func() {
+				var v struct{ T Time }
+				in := fmt.Sprintf(`{"T":"%s"}`, d)
+				Expect(json.Unmarshal([]byte(in), &v)).To(Succeed())
+				Expect(v.T.OriginalFormat).NotTo(BeEmpty())
+
+				out, err := json.Marshal(&v)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(out)).To(Equal(in))
+			})
+		}
+
+		It("should marshal the zero time as null", func() {
+			var v struct{ T Time }
+			Expect(json.Marshal(v)).To(Equal([]byte(`{"T":null}`)))
+		})
+
+		It("should unmarshal null as the zero time", func() {
+			v := struct{ T Time }{Time{Time: time.Now(), OriginalFormat: "2006-01-02"}}
+			Expect(json.Unmarshal([]byte(`{"T":null}`), &v)).To(Succeed())
+			Expect(v.T.IsZero()).To(BeTrue())
+			Expect(v.T.OriginalFormat).To(BeEmpty())
+		})
+
+		It("should be usable as a map key", func() {
+			var m map[Time]int
+			Expect(json.Unmarshal([]byte(`{"2018-W28":1}`), &m)).To(Succeed())
+			Expect(json.Marshal(m)).To(Equal([]byte(`{"2018-W28":1}`)))
+		})
+
+		It("should fall back to RFC3339 without an OriginalFormat", func() {
+			t := Time{Time: time.Date(2018, time.July, 14, 10, 5, 0, 0, time.UTC)}
+			Expect(t.MarshalJSON()).To(Equal([]byte(`"2018-07-14T10:05:00Z"`)))
+		})
+	})
+
+	Describe("Text", func() {
+		for _, d := range roundTrips {
+			d := d
+			It(fmt.Sprintf("should round trip %s", d), func() {
+				var t Time
+				Expect(t.UnmarshalText([]byte(d))).To(Succeed())
+				Expect(t.MarshalText()).To(Equal([]byte(d)))
+			})
+		}
+
+		It("should clear OriginalFormat for empty values", func() {
+			t := Time{OriginalFormat: "2006-01-02"}
+			Expect(t.UnmarshalText(nil)).To(Succeed())
+			Expect(t.IsZero()).To(BeTrue())
+			Expect(t.OriginalFormat).To(BeEmpty())
+		})
+	})
+
+	Describe("Binary", func() {
+		var t Time
+
+		BeforeEach(func() {
+			t = Time{}
+			Expect(t.UnmarshalText([]byte("2018-W28-6T10:05:00.123+05:30"))).To(Succeed())
+		})
+
+		It("should round trip", func() {
+			data, err := t.MarshalBinary()
+			Expect(err).NotTo(HaveOccurred())
+
+			var res Time
+			Expect(res.UnmarshalBinary(data)).To(Succeed())
+			Expect(res.Equal(t.Time)).To(BeTrue())
+			Expect(res.OriginalFormat).To(Equal(t.OriginalFormat))
+		})
+
+		It("should round trip through gob", func() {
+			var buf bytes.Buffer
+			Expect(gob.NewEncoder(&buf).Encode(t)).To(Succeed())
+
+			var res Time
+			Expect(gob.NewDecoder(&buf).Decode(&res)).To(Succeed())
+			Expect(res.Equal(t.Time)).To(BeTrue())
+			Expect(res.OriginalFormat).To(Equal(t.OriginalFormat))
+		})
+
+		It("should reject truncated data", func() {
+			data, err := t.MarshalBinary()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(new(Time).UnmarshalBinary(data[:5])).NotTo(Succeed())
+			Expect(new(Time).UnmarshalBinary(nil)).NotTo(Succeed())
+		})
+	})
+
+	Describe("Scan", func() {
+		var t Time
+		ref := time.Date(2018, time.July, 14, 10, 5, 0, 0, time.UTC)
+
+		BeforeEach(func() {
+			t = Time{}
+		})
+
+		It("should scan a time.Time", func() {
+			Expect(t.Scan(ref)).To(Succeed())
+			Expect(t.Time).To(Equal(ref))
+			Expect(t.OriginalFormat).To(BeEmpty())
+		})
+
+		It("should scan strings and bytes", func() {
+			Expect(t.Scan("2018-07-14T10:05:00Z")).To(Succeed())
+			Expect(t.Time).To(Equal(ref))
+			Expect(t.OriginalFormat).To(Equal("2006-01-02T15:04:05Z"))
+
+			Expect(t.Scan([]byte("2018-195"))).To(Succeed())
+			Expect(t.Time).To(Equal(time.Date(2018, time.July, 14, 0, 0, 0, 0, time.UTC)))
+			Expect(t.OriginalFormat).To(Equal("2006-002"))
+		})
+
+		It("should scan times with a space before the time", func() {
+			Expect(t.Scan([]byte("2018-07-14 10:05:00"))).To(Succeed())
+			Expect(t.Time).To(Equal(ref))
+			Expect(t.OriginalFormat).To(Equal("2006-01-02 15:04:05"))
+			Expect(t.MarshalText()).To(Equal([]byte("2018-07-14 10:05:00")))
+
+			Expect(t.Scan("2018-07-14 10:05:00.5")).To(Succeed())
+			Expect(t.Time).To(Equal(ref.Add(500 * time.Millisecond)))
+		})
+
+		It("should scan epochs", func() {
+			defer func(p time.Duration) { EpochPrecision = p }(EpochPrecision)
+
+			Expect(t.Scan(ref.Unix())).To(Succeed())
+			Expect(t.Time).To(Equal(ref))
+
+			EpochPrecision = time.Millisecond
+			Expect(t.Scan(ref.UnixNano() / 1e6)).To(Succeed())
+			Expect(t.Time).To(Equal(ref))
+
+			EpochPrecision = time.Microsecond
+			Expect(t.Scan(int64(-1))).To(Succeed())
+			Expect(t.Time).To(Equal(time.Unix(0, -1000).UTC()))
+
+			EpochPrecision = time.Nanosecond
+			Expect(t.Scan(int(ref.UnixNano()))).To(Succeed())
+			Expect(t.Time).To(Equal(ref))
+
+			EpochPrecision = time.Minute
+			Expect(t.Scan(int64(1))).NotTo(Succeed())
+		})
+
+		It("should reject other types", func() {
+			Expect(t.Scan(nil)).To(MatchError("Error converting <nil> to time"))
+			Expect(t.Scan(3.14)).To(MatchError("Error converting float64 to time"))
+			Expect(t.Scan("yesterday")).NotTo(Succeed())
+		})
+	})
+
+	Describe("Format", func() {
+		It("should format week dates", func() {
+			t := time.Date(2008, time.December, 28, 15, 4, 5, 0, time.UTC)
+			Expect(Format(t, WeekDayFormat+"T15:04:05")).To(Equal("2008-W52-7T15:04:05"))
+			Expect(Format(t, WeekFormatBasic)).To(Equal("2008W52"))
+			Expect(Format(t, "2006-01-02")).To(Equal("2008-12-28"))
+		})
+	})
+})
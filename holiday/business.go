@@ -0,0 +1,111 @@
+package holiday
+
+import (
+	"time"
+)
+
+// SatSun is the weekend in most of the world.
+var SatSun = []time.Weekday{time.Saturday, time.Sunday}
+
+// FriSat is the weekend in much of the Middle East.
+var FriSat = []time.Weekday{time.Friday, time.Saturday}
+
+// TradingCalendar is the business calendar of the US stock markets.
+// A trade settling T+2 settles on TradingCalendar.AddBusinessDays(trade, 2).
+var TradingCalendar = Calendar{Holidays: TradingHolidays}
+
+// FederalCalendar is the business calendar of the US government and most banks.
+var FederalCalendar = Calendar{Holidays: FederalHolidays}
+
+// Calendar is a business calendar: every day is a business day except the
+// Weekend and the observed dates of the Holidays. A nil Weekend means SatSun;
+// use an empty slice for a calendar without weekends.
+//
+// Each of the functions below works on dates: the time of day is kept
+// but otherwise ignored.
+type Calendar struct {
+	Holidays List
+	Weekend  []time.Weekday
+}
+
+// NewCalendar creates a Calendar with the given holidays and weekend.
+// If no weekend days are given, the weekend is SatSun.
+func NewCalendar(holidays List, weekend ...time.Weekday) Calendar {
+	if len(weekend) == 0 {
+		weekend = SatSun
+	}
+	return Calendar{Holidays: holidays, Weekend: weekend}
+}
+
+// IsWeekend determines whether <date> falls on the calendar's weekend.
+func (c Calendar) IsWeekend(date time.Time) bool {
+	weekend := c.Weekend
+	if weekend == nil {
+		weekend = SatSun
+	}
+	for _, day := range weekend {
+		if date.Weekday() == day {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusinessDay determines whether <date> is neither a weekend nor an observed holiday.
+func (c Calendar) IsBusinessDay(date time.Time) bool {
+	return !c.IsWeekend(date) && !c.Holidays.Observes(date)
+}
+
+// NextBusinessDay returns the first business day after <date>.
+func (c Calendar) NextBusinessDay(date time.Time) time.Time {
+	return c.AddBusinessDays(date, 1)
+}
+
+// PrevBusinessDay returns the last business day before <date>.
+func (c Calendar) PrevBusinessDay(date time.Time) time.Time {
+	return c.AddBusinessDays(date, -1)
+}
+
+// AddBusinessDays returns the date <n> business days after <date>, or before
+// it if n is negative. The date itself need not be a business day: two
+// business days after a Saturday is the following Tuesday. If n is zero,
+// the date is returned as is.
+func (c Calendar) AddBusinessDays(date time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		date = date.AddDate(0, 0, step)
+		if c.IsBusinessDay(date) {
+			n--
+		}
+	}
+	return date
+}
+
+// BusinessDaysBetween returns the number of business days after <a> up to and
+// including <b>, so that AddBusinessDays(a, BusinessDaysBetween(a, b)) is b
+// when b is a business day. If b is before a, the result is minus the number
+// of business days from b up to but not including a, for the same reason.
+func (c Calendar) BusinessDaysBetween(a, b time.Time) int {
+	if b.Before(a) {
+		return -c.countBusinessDays(b.AddDate(0, 0, -1), a.AddDate(0, 0, -1))
+	}
+	return c.countBusinessDays(a, b)
+}
+
+// countBusinessDays counts the business days after <a> up to and including <b>.
+func (c Calendar) countBusinessDays(a, b time.Time) int {
+	y, m, d := b.Date()
+	end := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	y, m, d = a.Date()
+
+	n := 0
+	for day := time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC); !day.After(end); day = day.AddDate(0, 0, 1) {
+		if c.IsBusinessDay(day) {
+			n++
+		}
+	}
+	return n
+}
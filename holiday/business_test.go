@@ -0,0 +1,66 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("Calendar", func() {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2018, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	// July 4th, 2018 was a Wednesday
+	It("should skip weekends and holidays", func() {
+		Expect(TradingCalendar.IsBusinessDay(date(time.July, 3))).To(BeTrue())
+		Expect(TradingCalendar.IsBusinessDay(date(time.July, 4))).To(BeFalse())
+		Expect(TradingCalendar.IsBusinessDay(date(time.July, 7))).To(BeFalse())
+		Expect(TradingCalendar.IsWeekend(date(time.July, 8))).To(BeTrue())
+	})
+
+	It("should skip observed holidays", func() {
+		// Christmas 2021 was a Saturday, observed on Friday the 24th
+		day := time.Date(2021, time.December, 24, 0, 0, 0, 0, time.UTC)
+		Expect(FederalCalendar.IsBusinessDay(day)).To(BeFalse())
+		Expect(FederalCalendar.NextBusinessDay(day.AddDate(0, 0, -1))).To(Equal(day.AddDate(0, 0, 3)))
+	})
+
+	It("should support other weekends", func() {
+		c := NewCalendar(nil, FriSat...)
+		Expect(c.IsBusinessDay(date(time.July, 6))).To(BeFalse())
+		Expect(c.IsBusinessDay(date(time.July, 8))).To(BeTrue())
+		Expect(c.NextBusinessDay(date(time.July, 5))).To(Equal(date(time.July, 8)))
+		Expect(Calendar{Weekend: []time.Weekday{}}.IsBusinessDay(date(time.July, 7))).To(BeTrue())
+		Expect(NewCalendar(nil).Weekend).To(Equal(SatSun))
+	})
+
+	It("should add business days", func() {
+		trade := time.Date(2018, time.July, 2, 15, 30, 0, 0, time.UTC)
+		Expect(TradingCalendar.AddBusinessDays(trade, 2)).To(Equal(trade.AddDate(0, 0, 3)))
+		Expect(TradingCalendar.AddBusinessDays(date(time.July, 6), -3)).To(Equal(date(time.July, 2)))
+		Expect(TradingCalendar.AddBusinessDays(date(time.July, 7), 2)).To(Equal(date(time.July, 10)))
+		Expect(TradingCalendar.AddBusinessDays(date(time.July, 7), 0)).To(Equal(date(time.July, 7)))
+	})
+
+	It("should find the next and previous business days", func() {
+		Expect(TradingCalendar.NextBusinessDay(date(time.July, 3))).To(Equal(date(time.July, 5)))
+		Expect(TradingCalendar.PrevBusinessDay(date(time.July, 5))).To(Equal(date(time.July, 3)))
+		Expect(TradingCalendar.NextBusinessDay(date(time.July, 6))).To(Equal(date(time.July, 9)))
+	})
+
+	It("should count the business days between two dates", func() {
+		Expect(TradingCalendar.BusinessDaysBetween(date(time.July, 2), date(time.July, 9))).To(Equal(4))
+		Expect(TradingCalendar.BusinessDaysBetween(date(time.July, 9), date(time.July, 2))).To(Equal(-4))
+		Expect(TradingCalendar.BusinessDaysBetween(date(time.July, 2), date(time.July, 2))).To(Equal(0))
+		Expect(TradingCalendar.BusinessDaysBetween(date(time.July, 7), date(time.July, 5))).To(Equal(-2))
+
+		for _, n := range []int{-10, -1, 1, 10} {
+			b := TradingCalendar.AddBusinessDays(date(time.July, 7), n)
+			Expect(TradingCalendar.BusinessDaysBetween(date(time.July, 7), b)).To(Equal(n))
+		}
+	})
+})
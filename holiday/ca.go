@@ -0,0 +1,303 @@
+package holiday
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/onwsk8r/gotime"
+)
+
+// CanadaFederalHolidays are the general holidays of the Canada Labour Code,
+// which apply to federally regulated employers such as banks.
+var CanadaFederalHolidays = List{
+	caHoliday("CA", "new-years-day", NYDay),
+	caHoliday("CA", "good-friday", GoodFriday),
+	caHoliday("CA", "victoria-day", VictoriaDay),
+	caHoliday("CA", "canada-day", CanadaDay),
+	caHoliday("CA", "labour-day", LaborDay),
+	caHoliday("CA", "truth-and-reconciliation", TruthAndReconciliationDay),
+	caHoliday("CA", "thanksgiving", CanadianThanksgiving),
+	caHoliday("CA", "remembrance-day", RemembranceDay),
+	caHoliday("CA", "christmas-day", ChristmasDay),
+	caHoliday("CA", "boxing-day", BoxingDay),
+}
+
+// The provincial lists below are the statutory (general, or paid public)
+// holidays of each province's employment standards. Where a province gives
+// the holiday its own name, such as Louis Riel Day for Family Day in
+// Manitoba, the list uses it.
+
+// AlbertaHolidays are the general holidays in Alberta.
+var AlbertaHolidays = List{
+	caHoliday("CA-AB", "new-years-day", NYDay),
+	caHoliday("CA-AB", "family-day", Since(1990, FamilyDay)),
+	caHoliday("CA-AB", "good-friday", GoodFriday),
+	caHoliday("CA-AB", "victoria-day", VictoriaDay),
+	caHoliday("CA-AB", "canada-day", CanadaDay),
+	caHoliday("CA-AB", "labour-day", LaborDay),
+	caHoliday("CA-AB", "thanksgiving", CanadianThanksgiving),
+	caHoliday("CA-AB", "remembrance-day", RemembranceDay),
+	caHoliday("CA-AB", "christmas-day", ChristmasDay),
+}
+
+// BritishColumbiaHolidays are the statutory holidays in British Columbia.
+var BritishColumbiaHolidays = List{
+	caHoliday("CA-BC", "new-years-day", NYDay),
+	caHoliday("CA-BC", "family-day", bcFamilyDay),
+	caHoliday("CA-BC", "good-friday", GoodFriday),
+	caHoliday("CA-BC", "victoria-day", VictoriaDay),
+	caHoliday("CA-BC", "canada-day", CanadaDay),
+	caHoliday("CA-BC", "bc-day", CivicHoliday),
+	caHoliday("CA-BC", "labour-day", LaborDay),
+	caHoliday("CA-BC", "truth-and-reconciliation", Since(2023, TruthAndReconciliationDay)),
+	caHoliday("CA-BC", "thanksgiving", CanadianThanksgiving),
+	caHoliday("CA-BC", "remembrance-day", RemembranceDay),
+	caHoliday("CA-BC", "christmas-day", ChristmasDay),
+}
+
+// ManitobaHolidays are the general holidays in Manitoba.
+var ManitobaHolidays = List{
+	caHoliday("CA-MB", "new-years-day", NYDay),
+	caHoliday("CA-MB", "louis-riel-day", Since(2008, FamilyDay)),
+	caHoliday("CA-MB", "good-friday", GoodFriday),
+	caHoliday("CA-MB", "victoria-day", VictoriaDay),
+	caHoliday("CA-MB", "canada-day", CanadaDay),
+	caHoliday("CA-MB", "labour-day", LaborDay),
+	caHoliday("CA-MB", "truth-and-reconciliation", Since(2023, TruthAndReconciliationDay)),
+	caHoliday("CA-MB", "thanksgiving", CanadianThanksgiving),
+	caHoliday("CA-MB", "christmas-day", ChristmasDay),
+}
+
+// NewBrunswickHolidays are the paid public holidays in New Brunswick.
+var NewBrunswickHolidays = List{
+	caHoliday("CA-NB", "new-years-day", NYDay),
+	caHoliday("CA-NB", "family-day", Since(2018, FamilyDay)),
+	caHoliday("CA-NB", "good-friday", GoodFriday),
+	caHoliday("CA-NB", "canada-day", CanadaDay),
+	caHoliday("CA-NB", "new-brunswick-day", CivicHoliday),
+	caHoliday("CA-NB", "labour-day", LaborDay),
+	caHoliday("CA-NB", "remembrance-day", RemembranceDay),
+	caHoliday("CA-NB", "christmas-day", ChristmasDay),
+}
+
+// NewfoundlandHolidays are the paid public holidays in Newfoundland and Labrador.
+var NewfoundlandHolidays = List{
+	caHoliday("CA-NL", "new-years-day", NYDay),
+	caHoliday("CA-NL", "good-friday", GoodFriday),
+	caHoliday("CA-NL", "canada-day", CanadaDay),
+	caHoliday("CA-NL", "labour-day", LaborDay),
+	caHoliday("CA-NL", "remembrance-day", RemembranceDay),
+	caHoliday("CA-NL", "christmas-day", ChristmasDay),
+}
+
+// NovaScotiaHolidays are the paid holidays in Nova Scotia.
+var NovaScotiaHolidays = List{
+	caHoliday("CA-NS", "new-years-day", NYDay),
+	caHoliday("CA-NS", "heritage-day", Since(2015, FamilyDay)),
+	caHoliday("CA-NS", "good-friday", GoodFriday),
+	caHoliday("CA-NS", "canada-day", CanadaDay),
+	caHoliday("CA-NS", "labour-day", LaborDay),
+	caHoliday("CA-NS", "christmas-day", ChristmasDay),
+}
+
+// OntarioHolidays are the public holidays in Ontario. The Civic Holiday
+// is not one of them, though many employers give it.
+var OntarioHolidays = List{
+	caHoliday("CA-ON", "new-years-day", NYDay),
+	caHoliday("CA-ON", "family-day", Since(2008, FamilyDay)),
+	caHoliday("CA-ON", "good-friday", GoodFriday),
+	caHoliday("CA-ON", "victoria-day", VictoriaDay),
+	caHoliday("CA-ON", "canada-day", CanadaDay),
+	caHoliday("CA-ON", "labour-day", LaborDay),
+	caHoliday("CA-ON", "thanksgiving", CanadianThanksgiving),
+	caHoliday("CA-ON", "christmas-day", ChristmasDay),
+	caHoliday("CA-ON", "boxing-day", BoxingDay),
+}
+
+// PrinceEdwardIslandHolidays are the paid holidays in Prince Edward Island.
+var PrinceEdwardIslandHolidays = List{
+	caHoliday("CA-PE", "new-years-day", NYDay),
+	caHoliday("CA-PE", "islander-day", islanderDay),
+	caHoliday("CA-PE", "good-friday", GoodFriday),
+	caHoliday("CA-PE", "canada-day", CanadaDay),
+	caHoliday("CA-PE", "labour-day", LaborDay),
+	caHoliday("CA-PE", "truth-and-reconciliation", Since(2022, TruthAndReconciliationDay)),
+	caHoliday("CA-PE", "remembrance-day", RemembranceDay),
+	caHoliday("CA-PE", "christmas-day", ChristmasDay),
+}
+
+// QuebecHolidays are the statutory holidays in Quebec. Employers may give
+// Easter Monday instead of Good Friday.
+var QuebecHolidays = List{
+	caHoliday("CA-QC", "new-years-day", NYDay),
+	caHoliday("CA-QC", "good-friday", GoodFriday),
+	caHoliday("CA-QC", "patriots-day", Since(2003, VictoriaDay)),
+	caHoliday("CA-QC", "saint-jean-baptiste", SaintJeanBaptisteDay),
+	caHoliday("CA-QC", "canada-day", CanadaDay),
+	caHoliday("CA-QC", "labour-day", LaborDay),
+	caHoliday("CA-QC", "thanksgiving", CanadianThanksgiving),
+	caHoliday("CA-QC", "christmas-day", ChristmasDay),
+}
+
+// SaskatchewanHolidays are the public holidays in Saskatchewan.
+var SaskatchewanHolidays = List{
+	caHoliday("CA-SK", "new-years-day", NYDay),
+	caHoliday("CA-SK", "family-day", Since(2007, FamilyDay)),
+	caHoliday("CA-SK", "good-friday", GoodFriday),
+	caHoliday("CA-SK", "victoria-day", VictoriaDay),
+	caHoliday("CA-SK", "canada-day", CanadaDay),
+	caHoliday("CA-SK", "saskatchewan-day", CivicHoliday),
+	caHoliday("CA-SK", "labour-day", LaborDay),
+	caHoliday("CA-SK", "thanksgiving", CanadianThanksgiving),
+	caHoliday("CA-SK", "remembrance-day", RemembranceDay),
+	caHoliday("CA-SK", "christmas-day", ChristmasDay),
+}
+
+// caNames are the English and French names of the Canadian holidays, by ID.
+var caNames = map[string][2]string{
+	"new-years-day":            {"New Year's Day", "Jour de l'An"},
+	"family-day":               {"Family Day", "Fête de la famille"},
+	"louis-riel-day":           {"Louis Riel Day", "Journée Louis Riel"},
+	"heritage-day":             {"Heritage Day", "Jour du patrimoine"},
+	"islander-day":             {"Islander Day", "Fête des Insulaires"},
+	"good-friday":              {"Good Friday", "Vendredi saint"},
+	"victoria-day":             {"Victoria Day", "Fête de la Reine"},
+	"patriots-day":             {"National Patriots' Day", "Journée nationale des patriotes"},
+	"saint-jean-baptiste":      {"Saint-Jean-Baptiste Day", "Fête nationale du Québec"},
+	"canada-day":               {"Canada Day", "Fête du Canada"},
+	"bc-day":                   {"British Columbia Day", "Jour de la Colombie-Britannique"},
+	"new-brunswick-day":        {"New Brunswick Day", "Fête du Nouveau-Brunswick"},
+	"saskatchewan-day":         {"Saskatchewan Day", "Fête de la Saskatchewan"},
+	"labour-day":               {"Labour Day", "Fête du Travail"},
+	"truth-and-reconciliation": {"National Day for Truth and Reconciliation", "Journée nationale de la vérité et de la réconciliation"},
+	"thanksgiving":             {"Thanksgiving", "Action de grâce"},
+	"remembrance-day":          {"Remembrance Day", "Jour du Souvenir"},
+	"christmas-day":            {"Christmas Day", "Noël"},
+	"boxing-day":               {"Boxing Day", "Lendemain de Noël"},
+}
+
+// caHoliday returns a Canadian holiday with its English and French names.
+// When a holiday falls on a weekend it is observed the following Monday,
+// except Canada Day and Saint-Jean-Baptiste Day, which are observed the
+// following day only when they fall on a Sunday.
+func caHoliday(region, id string, f Finder) Holiday {
+	rule := FollowingMonday
+	if id == "canada-day" || id == "saint-jean-baptiste" {
+		rule = SundayToMonday
+	}
+	return Holiday{
+		ID:     fmt.Sprintf("%s-%s", strings.ToLower(region), id),
+		Name:   caNames[id][0],
+		Names:  map[string]string{"en": caNames[id][0], "fr": caNames[id][1]},
+		Region: region,
+		Type:   Public,
+		Finder: f,
+		Rule:   rule,
+	}
+}
+
+// VictoriaDay returns the date of Victoria Day.
+// Victoria Day is the last Monday before May 25 and has been a Canadian
+// holiday since 1952; before then it was May 24, Queen Victoria's birthday.
+func VictoriaDay(year ...int) time.Time {
+	y := parseYear(year...)
+	switch {
+	case y < 1901:
+		return time.Time{}
+	case y < 1952:
+		return time.Date(y, time.May, 24, 0, 0, 0, 0, time.UTC)
+	}
+	return gotime.WeekdayBefore(y, time.May, 25, time.Monday)
+}
+
+// CanadaDay returns the date of Canada Day.
+// Canada Day, formerly Dominion Day, is July 1 and has been a holiday since 1879.
+func CanadaDay(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < 1879 {
+		return time.Time{}
+	}
+	return time.Date(y, time.July, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// CanadianThanksgiving returns the date of Thanksgiving in Canada.
+// It has been the second Monday in October since 1957; before then
+// its date was proclaimed each year.
+func CanadianThanksgiving(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < 1957 {
+		return time.Time{}
+	}
+	return gotime.NthWeekday(y, time.October, 2, time.Monday)
+}
+
+// RemembranceDay returns the date of Remembrance Day.
+// Remembrance Day is Nov 11 and has been a Canadian holiday since 1931.
+func RemembranceDay(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < 1931 {
+		return time.Time{}
+	}
+	return time.Date(y, time.November, 11, 0, 0, 0, 0, time.UTC)
+}
+
+// TruthAndReconciliationDay returns the date of the National Day for Truth
+// and Reconciliation. It is Sep 30 and has been a federal holiday since 2021.
+func TruthAndReconciliationDay(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < 2021 {
+		return time.Time{}
+	}
+	return time.Date(y, time.September, 30, 0, 0, 0, 0, time.UTC)
+}
+
+// FamilyDay returns the date of Family Day.
+// Family Day is the third Monday in February in the provinces that have it,
+// though it goes by other names in some of them. Each province adopted it in
+// a different year; the lists use Since for that.
+func FamilyDay(year ...int) time.Time {
+	y := parseYear(year...)
+	return gotime.NthWeekday(y, time.February, 3, time.Monday)
+}
+
+// bcFamilyDay returns the date of Family Day in British Columbia, which was
+// the second Monday in February from 2013 until 2018.
+func bcFamilyDay(year ...int) time.Time {
+	y := parseYear(year...)
+	switch {
+	case y < 2013:
+		return time.Time{}
+	case y < 2019:
+		return gotime.NthWeekday(y, time.February, 2, time.Monday)
+	}
+	return FamilyDay(y)
+}
+
+// islanderDay returns the date of Islander Day in Prince Edward Island,
+// which was the second Monday in February in its first year, 2009.
+func islanderDay(year ...int) time.Time {
+	y := parseYear(year...)
+	switch {
+	case y < 2009:
+		return time.Time{}
+	case y == 2009:
+		return gotime.NthWeekday(y, time.February, 2, time.Monday)
+	}
+	return FamilyDay(y)
+}
+
+// SaintJeanBaptisteDay returns the date of Saint-Jean-Baptiste Day, the
+// Fête nationale du Québec. It is June 24.
+func SaintJeanBaptisteDay(year ...int) time.Time {
+	y := parseYear(year...)
+	return time.Date(y, time.June, 24, 0, 0, 0, 0, time.UTC)
+}
+
+// CivicHoliday returns the date of the Civic Holiday.
+// The Civic Holiday is the first Monday in August. It is a statutory holiday
+// in some provinces, under names such as British Columbia Day.
+func CivicHoliday(year ...int) time.Time {
+	y := parseYear(year...)
+	return gotime.FirstWeekday(y, time.August, time.Monday)
+}
@@ -0,0 +1,86 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("Canadian holidays", func() {
+	federal := NewCalendar(CanadaFederalHolidays)
+
+	It("should find the holidays", func() {
+		Expect(VictoriaDay(2018).Format("20060102")).To(Equal("20180521"))
+		Expect(VictoriaDay(2020).Format("20060102")).To(Equal("20200518"))
+		Expect(VictoriaDay(1950).Format("20060102")).To(Equal("19500524"))
+		Expect(CanadaDay(2018).Format("20060102")).To(Equal("20180701"))
+		Expect(CanadianThanksgiving(2018).Format("20060102")).To(Equal("20181008"))
+		Expect(RemembranceDay(2018).Format("20060102")).To(Equal("20181111"))
+		Expect(FamilyDay(2018).Format("20060102")).To(Equal("20180219"))
+		Expect(CivicHoliday(2018).Format("20060102")).To(Equal("20180806"))
+		Expect(SaintJeanBaptisteDay(2018).Format("20060102")).To(Equal("20180624"))
+		Expect(TruthAndReconciliationDay(2020).IsZero()).To(BeTrue())
+		Expect(TruthAndReconciliationDay(2021).Format("20060102")).To(Equal("20210930"))
+	})
+
+	It("should observe Canada Day on Monday when it falls on Sunday", func() {
+		Expect(federal.IsBusinessDay(date(2018, time.July, 2))).To(BeFalse())
+		res := CanadaFederalHolidays.Lookup(date(2018, time.July, 2))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].String()).To(Equal("Canada Day (observed)"))
+		Expect(res[0].Holiday.LocalName("fr")).To(Equal("Fête du Canada"))
+	})
+
+	It("should substitute Christmas and Boxing Day", func() {
+		ontario := NewCalendar(OntarioHolidays)
+		Expect(ontario.IsBusinessDay(date(2022, time.December, 26))).To(BeFalse())
+		Expect(ontario.IsBusinessDay(date(2022, time.December, 27))).To(BeFalse())
+
+		quebec := NewCalendar(QuebecHolidays)
+		Expect(quebec.IsBusinessDay(date(2022, time.December, 26))).To(BeFalse())
+		Expect(quebec.IsBusinessDay(date(2022, time.December, 27))).To(BeTrue())
+	})
+
+	It("should have the provincial variants of Family Day", func() {
+		bc := NewCalendar(BritishColumbiaHolidays)
+		Expect(bc.IsBusinessDay(date(2018, time.February, 12))).To(BeFalse())
+		Expect(bc.IsBusinessDay(date(2018, time.February, 19))).To(BeTrue())
+		Expect(bc.IsBusinessDay(date(2019, time.February, 18))).To(BeFalse())
+		Expect(bc.IsBusinessDay(date(2012, time.February, 20))).To(BeTrue())
+
+		Expect(NewCalendar(PrinceEdwardIslandHolidays).IsBusinessDay(date(2009, time.February, 9))).To(BeFalse())
+		Expect(NewCalendar(OntarioHolidays).IsBusinessDay(date(2007, time.February, 19))).To(BeTrue())
+		Expect(NewCalendar(OntarioHolidays).IsBusinessDay(date(2008, time.February, 18))).To(BeFalse())
+
+		res := ManitobaHolidays.Lookup(date(2018, time.February, 19))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Holiday.Name).To(Equal("Louis Riel Day"))
+	})
+
+	It("should have Quebec's holidays", func() {
+		quebec := NewCalendar(QuebecHolidays)
+		Expect(quebec.IsBusinessDay(date(2018, time.June, 25))).To(BeFalse())
+		Expect(quebec.IsBusinessDay(date(2018, time.May, 21))).To(BeFalse())
+		res := QuebecHolidays.Lookup(date(2018, time.May, 21))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Holiday.LocalName("fr")).To(Equal("Journée nationale des patriotes"))
+		Expect(NewCalendar(OntarioHolidays).IsBusinessDay(date(2018, time.June, 25))).To(BeTrue())
+	})
+
+	It("should only have Remembrance Day and the Civic Holiday where they apply", func() {
+		Expect(NewCalendar(AlbertaHolidays).IsBusinessDay(date(2019, time.November, 11))).To(BeFalse())
+		Expect(NewCalendar(OntarioHolidays).IsBusinessDay(date(2019, time.November, 11))).To(BeTrue())
+		Expect(NewCalendar(SaskatchewanHolidays).IsBusinessDay(date(2019, time.August, 5))).To(BeFalse())
+		Expect(NewCalendar(OntarioHolidays).IsBusinessDay(date(2019, time.August, 5))).To(BeTrue())
+	})
+
+	It("should have Truth and Reconciliation Day from 2021", func() {
+		Expect(federal.IsBusinessDay(date(2021, time.September, 30))).To(BeFalse())
+		bc := NewCalendar(BritishColumbiaHolidays)
+		Expect(bc.IsBusinessDay(date(2022, time.September, 30))).To(BeTrue())
+		Expect(bc.IsBusinessDay(date(2023, time.October, 2))).To(BeFalse()) // Saturday, observed Monday
+	})
+})
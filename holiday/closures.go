@@ -0,0 +1,91 @@
+package holiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// TradingClosures are the days since 1963 the US stock markets closed for
+// something other than a regular holiday: presidential funerals and days
+// of mourning, storms, blackouts, and the 1968 paperwork crisis, when the
+// NYSE closed on Wednesdays to catch up on its backlog.
+var TradingClosures = List{
+	closure(1963, time.November, 25, "Funeral of President Kennedy"),
+	closure(1968, time.April, 9, "Day of mourning for Martin Luther King Jr."),
+	closure(1968, time.June, 12, "Paperwork crisis"),
+	closure(1968, time.June, 19, "Paperwork crisis"),
+	closure(1968, time.June, 26, "Paperwork crisis"),
+	closure(1968, time.July, 5, "Day after Independence Day"),
+	closure(1968, time.July, 10, "Paperwork crisis"),
+	closure(1968, time.July, 17, "Paperwork crisis"),
+	closure(1968, time.July, 24, "Paperwork crisis"),
+	closure(1968, time.July, 31, "Paperwork crisis"),
+	closure(1968, time.August, 7, "Paperwork crisis"),
+	closure(1968, time.August, 14, "Paperwork crisis"),
+	closure(1968, time.August, 21, "Paperwork crisis"),
+	closure(1968, time.August, 28, "Paperwork crisis"),
+	closure(1968, time.September, 11, "Paperwork crisis"),
+	closure(1968, time.September, 18, "Paperwork crisis"),
+	closure(1968, time.September, 25, "Paperwork crisis"),
+	closure(1968, time.October, 2, "Paperwork crisis"),
+	closure(1968, time.October, 9, "Paperwork crisis"),
+	closure(1968, time.October, 16, "Paperwork crisis"),
+	closure(1968, time.October, 23, "Paperwork crisis"),
+	closure(1968, time.October, 30, "Paperwork crisis"),
+	closure(1968, time.November, 13, "Paperwork crisis"),
+	closure(1968, time.November, 20, "Paperwork crisis"),
+	closure(1968, time.December, 4, "Paperwork crisis"),
+	closure(1968, time.December, 11, "Paperwork crisis"),
+	closure(1968, time.December, 18, "Paperwork crisis"),
+	closure(1969, time.February, 10, "Snowstorm"),
+	closure(1969, time.March, 31, "Funeral of President Eisenhower"),
+	closure(1969, time.July, 21, "Apollo 11 moon landing"),
+	closure(1972, time.December, 28, "Funeral of President Truman"),
+	closure(1973, time.January, 25, "Funeral of President Johnson"),
+	closure(1977, time.July, 14, "New York City blackout"),
+	closure(1985, time.September, 27, "Hurricane Gloria"),
+	closure(1994, time.April, 27, "Funeral of President Nixon"),
+	closure(2001, time.September, 11, "September 11 attacks"),
+	closure(2001, time.September, 12, "September 11 attacks"),
+	closure(2001, time.September, 13, "September 11 attacks"),
+	closure(2001, time.September, 14, "September 11 attacks"),
+	closure(2004, time.June, 11, "Funeral of President Reagan"),
+	closure(2007, time.January, 2, "Day of mourning for President Ford"),
+	closure(2012, time.October, 29, "Hurricane Sandy"),
+	closure(2012, time.October, 30, "Hurricane Sandy"),
+	closure(2018, time.December, 5, "Day of mourning for President George H. W. Bush"),
+	closure(2025, time.January, 9, "Day of mourning for President Carter"),
+}
+
+// Once returns a Finder for a holiday that happened on a single date,
+// such as a day of mourning. In any other year it returns the zero time.
+func Once(year int, month time.Month, day int) Finder {
+	return func(y ...int) time.Time {
+		if parseYear(y...) != year {
+			return time.Time{}
+		}
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// closure returns a one-off market holiday with the given name.
+func closure(year int, month time.Month, day int, name string) Holiday {
+	return Holiday{
+		ID:     fmt.Sprintf("us-nyse-closure-%04d-%02d-%02d", year, month, day),
+		Name:   name,
+		Region: "US",
+		Type:   Market,
+		Finder: Once(year, month, day),
+	}
+}
+
+// tradingElectionDay returns Election Day in the years the stock markets
+// closed for it: every year until 1968, then presidential election years
+// until 1980.
+func tradingElectionDay(year ...int) time.Time {
+	y := parseYear(year...)
+	if y > 1980 || (y > 1968 && y%4 != 0) {
+		return time.Time{}
+	}
+	return ElectionDay(y)
+}
@@ -0,0 +1,51 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("Trading closures", func() {
+	It("should find a one-off holiday only in its year", func() {
+		f := Once(2001, time.September, 11)
+		Expect(f(2001)).To(Equal(date(2001, time.September, 11)))
+		Expect(f(2002).IsZero()).To(BeTrue())
+	})
+
+	It("should find a holiday only since it began", func() {
+		f := Since(1998, MLKDay)
+		Expect(f(1997).IsZero()).To(BeTrue())
+		Expect(f(1998)).To(Equal(MLKDay(1998)))
+	})
+
+	It("should close the markets on one-off closures", func() {
+		for _, d := range []time.Time{
+			date(1963, time.November, 25),
+			date(1968, time.June, 12),
+			date(1977, time.July, 14),
+			date(2001, time.September, 14),
+			date(2012, time.October, 29),
+			date(2018, time.December, 5),
+		} {
+			Expect(TradingCalendar.IsBusinessDay(d)).To(BeFalse(), d.String())
+		}
+		Expect(TradingCalendar.NextBusinessDay(date(2001, time.September, 10))).To(Equal(date(2001, time.September, 17)))
+		Expect(FederalCalendar.IsBusinessDay(date(2001, time.September, 11))).To(BeTrue())
+	})
+
+	It("should follow the history of the trading holidays", func() {
+		Expect(TradingCalendar.IsBusinessDay(date(1997, time.January, 20))).To(BeTrue()) // MLK Day
+		Expect(TradingCalendar.IsBusinessDay(date(1998, time.January, 19))).To(BeFalse())
+		Expect(TradingCalendar.IsBusinessDay(date(2021, time.June, 18))).To(BeTrue()) // Juneteenth observed
+		Expect(TradingCalendar.IsBusinessDay(date(2022, time.June, 20))).To(BeFalse())
+		Expect(FederalCalendar.IsBusinessDay(date(2021, time.June, 18))).To(BeFalse())
+		Expect(TradingCalendar.IsBusinessDay(date(1968, time.November, 5))).To(BeFalse()) // Election Day
+		Expect(TradingCalendar.IsBusinessDay(date(1980, time.November, 4))).To(BeFalse())
+		Expect(TradingCalendar.IsBusinessDay(date(1978, time.November, 7))).To(BeTrue())
+		Expect(TradingCalendar.IsBusinessDay(date(1984, time.November, 6))).To(BeTrue())
+	})
+})
@@ -0,0 +1,126 @@
+package holiday
+
+import (
+	"time"
+
+	"github.com/onwsk8r/gotime"
+)
+
+// GermanyHolidays are the public holidays in all of Germany. Each state
+// (Bundesland) has more; see GermanyStateHolidays.
+var GermanyHolidays = List{
+	deHoliday("DE", "new-years-day", "New Year's Day", "Neujahr", NYDay),
+	deHoliday("DE", "good-friday", "Good Friday", "Karfreitag", GoodFriday),
+	deHoliday("DE", "easter-monday", "Easter Monday", "Ostermontag", EasterMonday),
+	deHoliday("DE", "labour-day", "Labour Day", "Tag der Arbeit", MayDay),
+	deHoliday("DE", "ascension-day", "Ascension Day", "Christi Himmelfahrt", AscensionDay),
+	deHoliday("DE", "whit-monday", "Whit Monday", "Pfingstmontag", WhitMonday),
+	deHoliday("DE", "german-unity-day", "German Unity Day", "Tag der Deutschen Einheit", GermanUnityDay),
+	deHoliday("DE", "repentance-day", "Day of Repentance and Prayer", "Buß- und Bettag", nationalRepentanceDay),
+	deHoliday("DE", "christmas-day", "Christmas Day", "1. Weihnachtstag", ChristmasDay),
+	deHoliday("DE", "st-stephens-day", "St. Stephen's Day", "2. Weihnachtstag", BoxingDay),
+}
+
+// GermanyStateHolidays are the public holidays in each German state, by
+// ISO 3166-2 code (eg "DE-BY" for Bavaria), including the national ones.
+// The Assumption is also a holiday in the Catholic municipalities of
+// Bavaria, and Corpus Christi in some of Saxony and Thuringia; those are
+// not included.
+var GermanyStateHolidays = map[string]List{
+	"DE-BW": deState("DE-BW", epiphany, corpusChristi, allSaints),
+	"DE-BY": deState("DE-BY", epiphany, corpusChristi, allSaints),
+	"DE-BE": deState("DE-BE", womensDay, liberationDay),
+	"DE-BB": deState("DE-BB", easterSunday, whitSunday, reformationDay),
+	"DE-HB": deState("DE-HB", reformationDaySince2017),
+	"DE-HH": deState("DE-HH", reformationDaySince2017),
+	"DE-HE": deState("DE-HE", corpusChristi),
+	"DE-MV": deState("DE-MV", womensDaySince2023, reformationDay),
+	"DE-NI": deState("DE-NI", reformationDaySince2017),
+	"DE-NW": deState("DE-NW", corpusChristi, allSaints),
+	"DE-RP": deState("DE-RP", corpusChristi, allSaints),
+	"DE-SL": deState("DE-SL", corpusChristi, assumption, allSaints),
+	"DE-SN": deState("DE-SN", reformationDay, stateRepentanceDay),
+	"DE-ST": deState("DE-ST", epiphany, reformationDay),
+	"DE-SH": deState("DE-SH", reformationDaySince2017),
+	"DE-TH": deState("DE-TH", childrensDay, reformationDay),
+}
+
+var (
+	epiphany                = localHoliday{"epiphany", "Epiphany", "Heilige Drei Könige", Epiphany}
+	corpusChristi           = localHoliday{"corpus-christi", "Corpus Christi", "Fronleichnam", CorpusChristi}
+	assumption              = localHoliday{"assumption-day", "Assumption Day", "Mariä Himmelfahrt", AssumptionDay}
+	allSaints               = localHoliday{"all-saints-day", "All Saints' Day", "Allerheiligen", AllSaintsDay}
+	easterSunday            = localHoliday{"easter-sunday", "Easter Sunday", "Ostersonntag", Easter}
+	whitSunday              = localHoliday{"whit-sunday", "Whit Sunday", "Pfingstsonntag", WhitSunday}
+	reformationDay          = localHoliday{"reformation-day", "Reformation Day", "Reformationstag", ReformationDay}
+	reformationDaySince2017 = localHoliday{"reformation-day", "Reformation Day", "Reformationstag", Since(2017, ReformationDay)}
+	stateRepentanceDay      = localHoliday{"repentance-day", "Day of Repentance and Prayer", "Buß- und Bettag", Since(1995, RepentanceDay)}
+	womensDay               = localHoliday{"womens-day", "International Women's Day", "Internationaler Frauentag", Since(2019, Fixed(time.March, 8))}
+	womensDaySince2023      = localHoliday{"womens-day", "International Women's Day", "Internationaler Frauentag", Since(2023, Fixed(time.March, 8))}
+	childrensDay            = localHoliday{"childrens-day", "World Children's Day", "Weltkindertag", Since(2019, Fixed(time.September, 20))}
+	liberationDay           = localHoliday{"liberation-day", "Liberation Day", "Tag der Befreiung", berlinLiberationDay}
+)
+
+// berlinLiberationDay returns May 8 in the years Berlin made the 75th and
+// 80th anniversaries of the end of the Second World War in Europe holidays.
+func berlinLiberationDay(year ...int) time.Time {
+	if y := parseYear(year...); y == 2020 || y == 2025 {
+		return time.Date(y, time.May, 8, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Time{}
+}
+
+// deState returns the holidays of a German state: the national ones, the
+// state's own, and Reformation Day in 2017, its 500th anniversary, if the
+// state does not otherwise have it. The northern states that made it a
+// holiday in 2018 have it since 2017.
+func deState(region string, extra ...localHoliday) List {
+	res := regionList(region, "de", GermanyHolidays, extra...)
+	for _, h := range extra {
+		if h.id == reformationDay.id {
+			return res
+		}
+	}
+	return append(res, deHoliday(region, "2017-reformation-day", "Reformation Day", "Reformationstag", Once(2017, time.October, 31)))
+}
+
+// deHoliday returns a German public holiday.
+func deHoliday(region, id, name, local string, f Finder) Holiday {
+	return euHoliday(region, id, name, "de", local, f)
+}
+
+// GermanUnityDay returns the date of German Unity Day.
+// It has been Oct 3 since reunification in 1990; from 1954 until then,
+// West Germany marked the Day of German Unity on June 17.
+func GermanUnityDay(year ...int) time.Time {
+	y := parseYear(year...)
+	switch {
+	case y < 1954:
+		return time.Time{}
+	case y < 1990:
+		return time.Date(y, time.June, 17, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(y, time.October, 3, 0, 0, 0, 0, time.UTC)
+}
+
+// ReformationDay returns the date of Reformation Day. It is Oct 31.
+func ReformationDay(year ...int) time.Time {
+	return Fixed(time.October, 31)(year...)
+}
+
+// RepentanceDay returns the date of the Day of Repentance and Prayer
+// (Buß- und Bettag), the last Wednesday before Nov 23.
+func RepentanceDay(year ...int) time.Time {
+	y := parseYear(year...)
+	return gotime.WeekdayBefore(y, time.November, 23, time.Wednesday)
+}
+
+// nationalRepentanceDay returns the Day of Repentance and Prayer in the
+// years it was a national holiday, until 1994. Since then, only Saxony
+// has kept it.
+func nationalRepentanceDay(year ...int) time.Time {
+	if parseYear(year...) > 1994 {
+		return time.Time{}
+	}
+	return RepentanceDay(year...)
+}
@@ -0,0 +1,91 @@
+package holiday
+
+import (
+	"time"
+
+	"github.com/onwsk8r/gotime"
+)
+
+// EarlyClose is a day a market closes early, at Close in the market's time zone.
+// The market only closes early when the day Finder returns is a trading day:
+// when that day is a weekend or an observed holiday, the market is closed anyway.
+type EarlyClose struct {
+	Finder Finder
+	Close  gotime.Clock
+}
+
+// TradingEarlyCloses are the days the US stock markets close early. The NYSE
+// and NASDAQ close at 1pm ET on the day after Thanksgiving and on Christmas
+// Eve, since 1993, and around Independence Day, since 1995: on July 3 when it
+// is Monday, Tuesday, or Thursday, and on Friday, July 5, from 1996 until 2012.
+// Since 2013 they also close early on a Wednesday, July 3, instead of the
+// Friday after. On a Friday, July 3 and Christmas Eve are observed holidays.
+// The handful of early closes before 1993, eg at 2pm in 1992, are not included.
+var TradingEarlyCloses = []EarlyClose{
+	{tradingIndependenceDayEve, gotime.Clock{Hour: 13}},
+	{tradingIndependenceDayAfter, gotime.Clock{Hour: 13}},
+	{Since(1993, BlackFriday), gotime.Clock{Hour: 13}},
+	{Since(1993, ChristmasEve), gotime.Clock{Hour: 13}},
+}
+
+// tradingIndependenceDayEve returns July 3 in the years the markets close
+// early on it: since 1995, and only since 2013 when it is a Wednesday.
+func tradingIndependenceDayEve(year ...int) time.Time {
+	t := Since(1995, IndependenceDayEve)(year...)
+	if t.Weekday() == time.Wednesday && t.Year() < 2013 {
+		return time.Time{}
+	}
+	return t
+}
+
+// tradingIndependenceDayAfter returns Friday, July 5, in the years the markets
+// closed early on it, from 1996 until 2012.
+func tradingIndependenceDayAfter(year ...int) time.Time {
+	y := parseYear(year...)
+	t := time.Date(y, time.July, 5, 0, 0, 0, 0, time.UTC)
+	if y < 1996 || y > 2012 || t.Weekday() != time.Friday {
+		return time.Time{}
+	}
+	return t
+}
+
+// IsEarlyClose determines whether the US stock markets close early on <date>.
+func IsEarlyClose(date time.Time) bool {
+	_, ok := FindEarlyClose(date, TradingEarlyCloses, TradingCalendar)
+	return ok
+}
+
+// EarlyCloseTime returns the time the US stock markets close on <date>, in
+// America/New_York, if they close early. If they do not, the second return
+// value is false. The date is taken as is, regardless of its location. This
+// function panics if the time zone database does not have America/New_York.
+func EarlyCloseTime(date time.Time) (time.Time, bool) {
+	c, ok := FindEarlyClose(date, TradingEarlyCloses, TradingCalendar)
+	if !ok {
+		return time.Time{}, false
+	}
+	return c.On(gotime.DateOf(date), newYork()), true
+}
+
+// FindEarlyClose finds <date> in a list of early closes for a market with the
+// given calendar and returns the time the market closes that day. If the market
+// does not close early that day, the second return value is false.
+func FindEarlyClose(date time.Time, closes []EarlyClose, calendar Calendar) (gotime.Clock, bool) {
+	if !calendar.IsBusinessDay(date) {
+		return gotime.Clock{}, false
+	}
+	// Some Finders, like NYEve, return a date in the year before the one they are given
+	for _, ec := range closes {
+		for _, y := range []int{date.Year(), date.Year() + 1} {
+			if gotime.DateEquals(date, ec.Finder(y)) {
+				return ec.Close, true
+			}
+		}
+	}
+	return gotime.Clock{}, false
+}
+
+// newYork returns the America/New_York location, loading it the first time.
+func newYork() *time.Location {
+	return mustLoadLocation("America/New_York")
+}
@@ -0,0 +1,62 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onwsk8r/gotime"
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("Early closes", func() {
+	It("should find the early closes in 2018", func() {
+		for _, d := range []time.Time{
+			date(2018, time.July, 3),
+			date(2018, time.November, 23),
+			date(2018, time.December, 24),
+		} {
+			Expect(IsEarlyClose(d)).To(BeTrue(), d.String())
+		}
+		Expect(IsEarlyClose(date(2018, time.July, 2))).To(BeFalse())
+		Expect(IsEarlyClose(date(2018, time.November, 22))).To(BeFalse())
+	})
+
+	It("should follow the history of the early closes", func() {
+		Expect(IsEarlyClose(date(2002, time.July, 3))).To(BeFalse()) // Wednesday, before 2013
+		Expect(IsEarlyClose(date(2002, time.July, 5))).To(BeTrue())  // Friday after Independence Day
+		Expect(IsEarlyClose(date(2019, time.July, 3))).To(BeTrue())  // Wednesday
+		Expect(IsEarlyClose(date(2019, time.July, 5))).To(BeFalse())
+		Expect(IsEarlyClose(date(1990, time.July, 3))).To(BeFalse())
+		Expect(IsEarlyClose(date(1991, time.November, 29))).To(BeFalse())
+		Expect(IsEarlyClose(date(1991, time.December, 24))).To(BeFalse())
+		Expect(IsEarlyClose(date(1993, time.November, 26))).To(BeTrue())
+	})
+
+	It("should not close early when the market is closed anyway", func() {
+		Expect(IsEarlyClose(date(2015, time.July, 3))).To(BeFalse())      // Friday, observed Independence Day
+		Expect(IsEarlyClose(date(2016, time.July, 3))).To(BeFalse())      // Sunday
+		Expect(IsEarlyClose(date(2021, time.December, 24))).To(BeFalse()) // Friday, observed Christmas
+		Expect(IsEarlyClose(date(2022, time.December, 24))).To(BeFalse()) // Saturday
+	})
+
+	It("should return the closing time in New York", func() {
+		ny, err := time.LoadLocation("America/New_York")
+		Expect(err).ToNot(HaveOccurred())
+
+		t, ok := EarlyCloseTime(date(2018, time.July, 3))
+		Expect(ok).To(BeTrue())
+		Expect(t).To(Equal(time.Date(2018, time.July, 3, 13, 0, 0, 0, ny)))
+
+		_, ok = EarlyCloseTime(date(2018, time.July, 5))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should find early closes in other lists", func() {
+		closes := []EarlyClose{{NYEve, gotime.Clock{Hour: 12, Minute: 30}}}
+		c, ok := FindEarlyClose(date(2018, time.December, 31), closes, TradingCalendar)
+		Expect(ok).To(BeTrue())
+		Expect(c).To(Equal(gotime.Clock{Hour: 12, Minute: 30}))
+	})
+})
@@ -32,6 +32,51 @@ func GoodFriday(year ...int) time.Time {
 	return Easter(y).Add(-24 * 2 * time.Hour)
 }
 
+// EasterMonday returns the date of Easter Monday for the given year.
+func EasterMonday(year ...int) time.Time {
+	return FromEaster(1)(year...)
+}
+
+// AshWednesday returns the date of Ash Wednesday, the start of Lent,
+// 46 days before Easter.
+func AshWednesday(year ...int) time.Time {
+	return FromEaster(-46)(year...)
+}
+
+// MaundyThursday returns the date of Maundy Thursday, the Thursday before Easter.
+func MaundyThursday(year ...int) time.Time {
+	return FromEaster(-3)(year...)
+}
+
+// AscensionDay returns the date of Ascension Day, 39 days after Easter.
+func AscensionDay(year ...int) time.Time {
+	return FromEaster(39)(year...)
+}
+
+// WhitSunday returns the date of Whit Sunday (Pentecost), 49 days after Easter.
+func WhitSunday(year ...int) time.Time {
+	return FromEaster(49)(year...)
+}
+
+// WhitMonday returns the date of Whit Monday, the day after Pentecost.
+func WhitMonday(year ...int) time.Time {
+	return FromEaster(50)(year...)
+}
+
+// CorpusChristi returns the date of Corpus Christi, 60 days after Easter.
+func CorpusChristi(year ...int) time.Time {
+	return FromEaster(60)(year...)
+}
+
+// FromEaster returns a Finder for a feast the given number of days
+// after Easter, or before it if days is negative.
+func FromEaster(days int) Finder {
+	return func(year ...int) time.Time {
+		y := parseYear(year...)
+		return Easter(y).AddDate(0, 0, days)
+	}
+}
+
 // The above Go function was adapted from the below SQL function,
 // which in turn was adapted from a C# function
 
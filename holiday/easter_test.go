@@ -15,4 +15,15 @@ var _ = Describe("Easter", func() {
 	It("Should find Good Friday", func() {
 		Expect(GoodFriday(2018).Format("20060102")).To(Equal("20180330"))
 	})
+
+	It("Should find the feasts around Easter", func() {
+		Expect(AshWednesday(2019).Format("20060102")).To(Equal("20190306"))
+		Expect(MaundyThursday(2019).Format("20060102")).To(Equal("20190418"))
+		Expect(EasterMonday(2019).Format("20060102")).To(Equal("20190422"))
+		Expect(AscensionDay(2019).Format("20060102")).To(Equal("20190530"))
+		Expect(WhitSunday(2019).Format("20060102")).To(Equal("20190609"))
+		Expect(WhitMonday(2019).Format("20060102")).To(Equal("20190610"))
+		Expect(CorpusChristi(2019).Format("20060102")).To(Equal("20190620"))
+		Expect(FromEaster(-2)(2018).Format("20060102")).To(Equal(GoodFriday(2018).Format("20060102")))
+	})
 })
@@ -0,0 +1,66 @@
+package holiday
+
+import (
+	"time"
+)
+
+// SpainHolidays are the national public holidays in Spain. Each autonomous
+// community has more; see SpainRegionHolidays.
+var SpainHolidays = List{
+	esHoliday("ES", "new-years-day", "New Year's Day", "Año Nuevo", NYDay),
+	esHoliday("ES", "epiphany", "Epiphany", "Epifanía del Señor", Epiphany),
+	esHoliday("ES", "good-friday", "Good Friday", "Viernes Santo", GoodFriday),
+	esHoliday("ES", "labour-day", "Labour Day", "Fiesta del Trabajo", MayDay),
+	esHoliday("ES", "assumption-day", "Assumption Day", "Asunción de la Virgen", AssumptionDay),
+	esHoliday("ES", "national-day", "National Day", "Fiesta Nacional de España", Fixed(time.October, 12)),
+	esHoliday("ES", "all-saints-day", "All Saints' Day", "Todos los Santos", AllSaintsDay),
+	esHoliday("ES", "constitution-day", "Constitution Day", "Día de la Constitución", Since(1983, Fixed(time.December, 6))),
+	esHoliday("ES", "immaculate-conception", "Immaculate Conception", "Inmaculada Concepción", ImmaculateConception),
+	esHoliday("ES", "christmas-day", "Christmas Day", "Natividad del Señor", ChristmasDay),
+}
+
+// SpainRegionHolidays are the public holidays in each autonomous community
+// of Spain, by ISO 3166-2 code (eg "ES-CT" for Catalonia), including the
+// national ones. The communities set their holidays each year, moving some
+// that fall on a Sunday to the Monday and swapping others; these lists have
+// each community's usual holidays.
+var SpainRegionHolidays = map[string]List{
+	"ES-AN": esRegion("ES-AN", maundyThursday, localHoliday{"andalusia-day", "Andalusia Day", "Día de Andalucía", Fixed(time.February, 28)}),
+	"ES-AR": esRegion("ES-AR", maundyThursday, localHoliday{"aragon-day", "Aragon Day", "Día de Aragón", Fixed(time.April, 23)}),
+	"ES-AS": esRegion("ES-AS", maundyThursday, localHoliday{"asturias-day", "Asturias Day", "Día de Asturias", Fixed(time.September, 8)}),
+	"ES-IB": esRegion("ES-IB", localHoliday{"balearic-islands-day", "Balearic Islands Day", "Día de las Illes Balears", Fixed(time.March, 1)}, maundyThursday, esEasterMonday),
+	"ES-CN": esRegion("ES-CN", maundyThursday, localHoliday{"canary-islands-day", "Canary Islands Day", "Día de Canarias", Fixed(time.May, 30)}),
+	"ES-CB": esRegion("ES-CB", maundyThursday),
+	"ES-CL": esRegion("ES-CL", localHoliday{"castile-and-leon-day", "Castile and León Day", "Día de Castilla y León", Fixed(time.April, 23)}, maundyThursday),
+	"ES-CM": esRegion("ES-CM", maundyThursday, localHoliday{"castile-la-mancha-day", "Castilla-La Mancha Day", "Día de Castilla-La Mancha", Fixed(time.May, 31)}),
+	"ES-CT": esRegion("ES-CT", esEasterMonday, stJohnsDay,
+		localHoliday{"national-day-of-catalonia", "National Day of Catalonia", "Diada Nacional de Catalunya", Fixed(time.September, 11)},
+		localHoliday{"st-stephens-day", "St. Stephen's Day", "Sant Esteve", BoxingDay}),
+	"ES-EX": esRegion("ES-EX", maundyThursday, localHoliday{"extremadura-day", "Extremadura Day", "Día de Extremadura", Fixed(time.September, 8)}),
+	"ES-GA": esRegion("ES-GA", maundyThursday,
+		localHoliday{"galician-literature-day", "Galician Literature Day", "Día de las Letras Gallegas", Fixed(time.May, 17)},
+		localHoliday{"galicia-day", "Galicia Day", "Día Nacional de Galicia", Fixed(time.July, 25)}),
+	"ES-MD": esRegion("ES-MD", maundyThursday, localHoliday{"madrid-day", "Community of Madrid Day", "Fiesta de la Comunidad de Madrid", Fixed(time.May, 2)}),
+	"ES-MC": esRegion("ES-MC", localHoliday{"murcia-day", "Region of Murcia Day", "Día de la Región de Murcia", Fixed(time.June, 9)}, maundyThursday),
+	"ES-NC": esRegion("ES-NC", maundyThursday, esEasterMonday),
+	"ES-PV": esRegion("ES-PV", maundyThursday, esEasterMonday),
+	"ES-RI": esRegion("ES-RI", maundyThursday, localHoliday{"la-rioja-day", "La Rioja Day", "Día de La Rioja", Fixed(time.June, 9)}),
+	"ES-VC": esRegion("ES-VC", localHoliday{"st-josephs-day", "St. Joseph's Day", "San José", Fixed(time.March, 19)}, esEasterMonday,
+		localHoliday{"valencian-community-day", "Valencian Community Day", "Día de la Comunitat Valenciana", Fixed(time.October, 9)}),
+}
+
+var (
+	maundyThursday = localHoliday{"maundy-thursday", "Maundy Thursday", "Jueves Santo", MaundyThursday}
+	esEasterMonday = localHoliday{"easter-monday", "Easter Monday", "Lunes de Pascua", EasterMonday}
+	stJohnsDay     = localHoliday{"st-johns-day", "St. John's Day", "Sant Joan", Fixed(time.June, 24)}
+)
+
+// esRegion returns the holidays of an autonomous community of Spain.
+func esRegion(region string, extra ...localHoliday) List {
+	return regionList(region, "es", SpainHolidays, extra...)
+}
+
+// esHoliday returns a Spanish public holiday.
+func esHoliday(region, id, name, local string, f Finder) Holiday {
+	return euHoliday(region, id, name, "es", local, f)
+}
@@ -0,0 +1,113 @@
+package holiday
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TARGET2Holidays are the days the TARGET2 payment system, and so euro
+// settlement, is closed. The system closed only on Christmas and New Year's
+// Day in its first year, 1999, and on New Year's Eve from 1999 until 2001.
+var TARGET2Holidays = List{
+	target2Holiday("new-years-day", "New Year's Day", NYDay),
+	target2Holiday("good-friday", "Good Friday", Since(2000, GoodFriday)),
+	target2Holiday("easter-monday", "Easter Monday", Since(2000, EasterMonday)),
+	target2Holiday("labour-day", "Labour Day", Since(2000, MayDay)),
+	target2Holiday("christmas-day", "Christmas Day", ChristmasDay),
+	target2Holiday("christmas-holiday", "Christmas Holiday", Since(2000, BoxingDay)),
+	target2Holiday("1999-new-years-eve", "New Year's Eve", Once(1999, time.December, 31)),
+	target2Holiday("2000-new-years-eve", "New Year's Eve", Once(2000, time.December, 31)),
+	target2Holiday("2001-new-years-eve", "New Year's Eve", Once(2001, time.December, 31)),
+}
+
+// TARGET2Calendar is the business calendar for euro settlement. A trade in
+// euros settling T+2 settles on TARGET2Calendar.AddBusinessDays(trade, 2).
+var TARGET2Calendar = Calendar{Holidays: TARGET2Holidays}
+
+// target2Holiday returns a TARGET2 closing day.
+func target2Holiday(id, name string, f Finder) Holiday {
+	return Holiday{
+		ID:     "eu-target2-" + id,
+		Name:   name,
+		Region: "EU",
+		Type:   Bank,
+		Finder: f,
+		Rule:   NoObservance,
+	}
+}
+
+// euHoliday returns a public holiday in a European country, with its name in
+// the local language. Holidays in the countries below are not moved when they
+// fall on a weekend.
+func euHoliday(region, id, name, lang, local string, f Finder) Holiday {
+	return Holiday{
+		ID:     fmt.Sprintf("%s-%s", strings.ToLower(region), id),
+		Name:   name,
+		Names:  map[string]string{lang: local},
+		Region: region,
+		Type:   Public,
+		Finder: f,
+		Rule:   NoObservance,
+	}
+}
+
+// localHoliday is a holiday of a state or region that regionList turns into
+// a Holiday for it.
+type localHoliday struct {
+	id, name, local string
+	finder          Finder
+}
+
+// regionList returns the holidays of a state or region: the national ones
+// in <base>, and the region's own, with their names in the language <lang>.
+func regionList(region, lang string, base List, extra ...localHoliday) List {
+	res := join(base)
+	for _, h := range extra {
+		res = append(res, euHoliday(region, h.id, h.name, lang, h.local, h.finder))
+	}
+	return res
+}
+
+// join returns a new List with the holidays in each of the lists.
+func join(lists ...List) List {
+	var res List
+	for _, l := range lists {
+		res = append(res, l...)
+	}
+	return res
+}
+
+// Fixed returns a Finder for a holiday on the same date every year.
+func Fixed(month time.Month, day int) Finder {
+	return func(year ...int) time.Time {
+		y := parseYear(year...)
+		return time.Date(y, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Epiphany returns the date of the Epiphany, aka Three Kings' Day. It is Jan 6.
+func Epiphany(year ...int) time.Time {
+	return Fixed(time.January, 6)(year...)
+}
+
+// MayDay returns the date of May Day, aka Labour Day in much of Europe. It is May 1.
+func MayDay(year ...int) time.Time {
+	return Fixed(time.May, 1)(year...)
+}
+
+// AssumptionDay returns the date of the Assumption of Mary. It is Aug 15.
+func AssumptionDay(year ...int) time.Time {
+	return Fixed(time.August, 15)(year...)
+}
+
+// AllSaintsDay returns the date of All Saints' Day. It is Nov 1.
+func AllSaintsDay(year ...int) time.Time {
+	return Fixed(time.November, 1)(year...)
+}
+
+// ImmaculateConception returns the date of the Feast of the Immaculate
+// Conception. It is Dec 8.
+func ImmaculateConception(year ...int) time.Time {
+	return Fixed(time.December, 8)(year...)
+}
@@ -0,0 +1,116 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("European holidays", func() {
+	Context("TARGET2", func() {
+		It("should be closed on its holidays", func() {
+			for _, d := range []time.Time{
+				date(2019, time.January, 1),
+				date(2019, time.April, 19),
+				date(2019, time.April, 22),
+				date(2019, time.May, 1),
+				date(2019, time.December, 25),
+				date(2019, time.December, 26),
+			} {
+				Expect(TARGET2Calendar.IsBusinessDay(d)).To(BeFalse(), d.String())
+			}
+			Expect(TARGET2Calendar.IsBusinessDay(date(2019, time.May, 30))).To(BeTrue())
+			Expect(TARGET2Calendar.IsBusinessDay(date(2019, time.December, 31))).To(BeTrue())
+		})
+
+		It("should follow the closing days of the early years", func() {
+			Expect(TARGET2Calendar.IsBusinessDay(date(1999, time.April, 2))).To(BeTrue())
+			Expect(TARGET2Calendar.IsBusinessDay(date(1999, time.December, 31))).To(BeFalse())
+			Expect(TARGET2Calendar.IsBusinessDay(date(2001, time.December, 31))).To(BeFalse())
+			Expect(TARGET2Calendar.IsBusinessDay(date(2002, time.December, 31))).To(BeTrue())
+		})
+
+		It("should settle around Easter", func() {
+			Expect(TARGET2Calendar.AddBusinessDays(date(2019, time.April, 18), 2)).To(Equal(date(2019, time.April, 24)))
+		})
+	})
+
+	Context("Germany", func() {
+		It("should have the state holidays", func() {
+			bavaria := NewCalendar(GermanyStateHolidays["DE-BY"])
+			Expect(bavaria.IsBusinessDay(date(2019, time.June, 20))).To(BeFalse())
+			Expect(NewCalendar(GermanyHolidays).IsBusinessDay(date(2019, time.June, 20))).To(BeTrue())
+			Expect(RepentanceDay(2019).Format("20060102")).To(Equal("20191120"))
+			Expect(NewCalendar(GermanyStateHolidays["DE-SN"]).IsBusinessDay(date(2019, time.November, 20))).To(BeFalse())
+			Expect(NewCalendar(GermanyStateHolidays["DE-BE"]).IsBusinessDay(date(2019, time.March, 8))).To(BeFalse())
+			Expect(NewCalendar(GermanyStateHolidays["DE-BE"]).IsBusinessDay(date(2018, time.March, 8))).To(BeTrue())
+		})
+
+		It("should have Reformation Day in every state in 2017", func() {
+			Expect(GermanyStateHolidays).To(HaveLen(16))
+			for region, l := range GermanyStateHolidays {
+				Expect(NewCalendar(l).IsBusinessDay(date(2017, time.October, 31))).To(BeFalse(), region)
+			}
+			Expect(NewCalendar(GermanyStateHolidays["DE-BY"]).IsBusinessDay(date(2018, time.October, 31))).To(BeTrue())
+			Expect(NewCalendar(GermanyStateHolidays["DE-NI"]).IsBusinessDay(date(2018, time.October, 31))).To(BeFalse())
+		})
+
+		It("should have local names", func() {
+			res := GermanyHolidays.Lookup(date(2019, time.October, 3))
+			Expect(res).To(HaveLen(1))
+			Expect(res[0].Holiday.ID).To(Equal("de-german-unity-day"))
+			Expect(res[0].Holiday.LocalName("de")).To(Equal("Tag der Deutschen Einheit"))
+			Expect(res[0].Holiday.LocalName("fr")).To(Equal("German Unity Day"))
+		})
+	})
+
+	Context("France", func() {
+		It("should have Bastille Day", func() {
+			Expect(BastilleDay(2019).Format("20060102")).To(Equal("20190714"))
+			Expect(FranceHolidays.Contains(date(2020, time.July, 14))).To(BeTrue())
+			Expect(FranceHolidays.Contains(date(2019, time.April, 19))).To(BeFalse())
+		})
+
+		It("should not move holidays on weekends", func() {
+			Expect(NewCalendar(FranceHolidays).IsBusinessDay(date(2019, time.July, 15))).To(BeTrue())
+		})
+	})
+
+	Context("Netherlands", func() {
+		It("should find King's Day", func() {
+			Expect(KingsDay(2014).Format("20060102")).To(Equal("20140426"))
+			Expect(KingsDay(2018).Format("20060102")).To(Equal("20180427"))
+			Expect(KingsDay(2013).Format("20060102")).To(Equal("20130430"))
+			Expect(KingsDay(2006).Format("20060102")).To(Equal("20060429"))
+			Expect(KingsDay(1978).Format("20060102")).To(Equal("19780501"))
+		})
+	})
+
+	Context("Italy", func() {
+		It("should find Republic Day", func() {
+			Expect(RepublicDay(2019).Format("20060102")).To(Equal("20190602"))
+			Expect(RepublicDay(1990).Format("20060102")).To(Equal("19900603"))
+			Expect(RepublicDay(1946).IsZero()).To(BeTrue())
+		})
+
+		It("should not have the Epiphany from 1977 until 1985", func() {
+			Expect(ItalyHolidays.Contains(date(1980, time.January, 6))).To(BeFalse())
+			Expect(ItalyHolidays.Contains(date(1986, time.January, 6))).To(BeTrue())
+			Expect(ItalyHolidays.Contains(date(1976, time.January, 6))).To(BeTrue())
+		})
+	})
+
+	Context("Spain", func() {
+		It("should have the community holidays", func() {
+			Expect(SpainRegionHolidays).To(HaveLen(17))
+			catalonia := NewCalendar(SpainRegionHolidays["ES-CT"])
+			Expect(catalonia.IsBusinessDay(date(2019, time.September, 11))).To(BeFalse())
+			Expect(catalonia.IsBusinessDay(date(2019, time.April, 18))).To(BeTrue())
+			Expect(NewCalendar(SpainRegionHolidays["ES-MD"]).IsBusinessDay(date(2019, time.April, 18))).To(BeFalse())
+			Expect(NewCalendar(SpainHolidays).IsBusinessDay(date(2019, time.September, 11))).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,242 @@
+package holiday
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/onwsk8r/gotime"
+)
+
+// Hours are the times a session opens and closes on a trading day. If Close
+// is not after Open, the session spans midnight and opens the day before,
+// eg a futures session from 17:00 to 16:00 opens at 17:00 on the previous day.
+type Hours struct {
+	Open, Close gotime.Clock
+}
+
+// IsZero determines whether the hours are unset, ie there is no such session.
+func (h Hours) IsZero() bool {
+	return h == Hours{}
+}
+
+// Exchange describes when a trading venue is open. Exchanges are declared
+// rather than computed, so a new one only needs its time zone, its hours, and
+// its calendar; for example, the Tokyo Stock Exchange closes for lunch:
+//
+//	tse := holiday.Exchange{
+//		Name:     "TSE",
+//		Zone:     "Asia/Tokyo",
+//		Calendar: holiday.NewCalendar(japaneseHolidays),
+//		Regular: []holiday.Hours{
+//			{Open: gotime.Clock{Hour: 9}, Close: gotime.Clock{Hour: 11, Minute: 30}},
+//			{Open: gotime.Clock{Hour: 12, Minute: 30}, Close: gotime.Clock{Hour: 15, Minute: 30}},
+//		},
+//	}
+//
+// Each trading day is a day on the Calendar. Every time is evaluated in the
+// exchange's time zone, and every time returned is in it. Methods panic if the
+// time zone database does not have the Zone, so call Validate when declaring
+// an exchange. Programs that run where there is no time zone database, such
+// as minimal containers, can import time/tzdata in their main package.
+type Exchange struct {
+	Name     string
+	Zone     string   // The IANA time zone, eg America/New_York
+	Calendar Calendar // The trading days
+
+	// Regular is the regular session. It has more than one Hours if the
+	// exchange closes during the day, eg for lunch.
+	Regular []Hours
+
+	// PreMarket and PostMarket are the extended hours sessions, if any.
+	// The post-market session begins when the regular session closes,
+	// early or not.
+	PreMarket, PostMarket Hours
+
+	// EarlyCloses are days the regular session closes early.
+	EarlyCloses []EarlyClose
+}
+
+// NYSE is the New York Stock Exchange. Its regular session is 9:30am to 4pm ET,
+// with extended hours from 4am and until 8pm.
+var NYSE = Exchange{
+	Name:        "NYSE",
+	Zone:        "America/New_York",
+	Calendar:    TradingCalendar,
+	Regular:     []Hours{{gotime.Clock{Hour: 9, Minute: 30}, gotime.Clock{Hour: 16}}},
+	PreMarket:   Hours{gotime.Clock{Hour: 4}, gotime.Clock{Hour: 9, Minute: 30}},
+	PostMarket:  Hours{gotime.Clock{Hour: 16}, gotime.Clock{Hour: 20}},
+	EarlyCloses: TradingEarlyCloses,
+}
+
+// NASDAQ is the Nasdaq Stock Market, which keeps the same hours as the NYSE.
+var NASDAQ = Exchange{
+	Name:        "NASDAQ",
+	Zone:        "America/New_York",
+	Calendar:    TradingCalendar,
+	Regular:     []Hours{{gotime.Clock{Hour: 9, Minute: 30}, gotime.Clock{Hour: 16}}},
+	PreMarket:   Hours{gotime.Clock{Hour: 4}, gotime.Clock{Hour: 9, Minute: 30}},
+	PostMarket:  Hours{gotime.Clock{Hour: 16}, gotime.Clock{Hour: 20}},
+	EarlyCloses: TradingEarlyCloses,
+}
+
+// maxClosedDays is how far NextOpen and NextClose look for a session.
+const maxClosedDays = 366
+
+// Validate returns an error if the time zone database does not have the
+// exchange's Zone.
+func (e Exchange) Validate() error {
+	_, err := loadLocation(e.Zone)
+	return errors.Annotatef(err, "Exchange %s", e.Name)
+}
+
+// Location returns the exchange's time zone. It panics if the time zone
+// database does not have it; see Validate.
+func (e Exchange) Location() *time.Location {
+	return mustLoadLocation(e.Zone)
+}
+
+// IsTradingDay determines whether the exchange has a session on <date>.
+func (e Exchange) IsTradingDay(date time.Time) bool {
+	return e.Calendar.IsBusinessDay(utcDate(date))
+}
+
+// Sessions returns the regular session of a trading day: one interval per
+// Hours, cut short on an early close. It returns nil if <date> is not a
+// trading day.
+func (e Exchange) Sessions(date time.Time) []gotime.Interval {
+	date = utcDate(date)
+	if !e.IsTradingDay(date) {
+		return nil
+	}
+
+	loc := e.Location()
+	d := gotime.DateOf(date)
+	closing, early := FindEarlyClose(date, e.EarlyCloses, e.Calendar)
+	var res []gotime.Interval
+	for _, h := range e.Regular {
+		open, end := h.Open.On(d, loc), h.Close.On(d, loc)
+		if !end.After(open) {
+			open = h.Open.On(d.AddDays(-1), loc)
+		}
+		if early {
+			if cutoff := closing.On(d, loc); !open.Before(cutoff) {
+				break
+			} else if end.After(cutoff) {
+				end = cutoff
+			}
+		}
+		res = append(res, gotime.NewInterval(open, end))
+	}
+	return res
+}
+
+// IsOpen determines whether the exchange's regular session is open at t.
+func (e Exchange) IsOpen(t time.Time) bool {
+	t = t.In(e.Location())
+	for _, d := range []time.Time{utcDate(t), utcDate(t).AddDate(0, 0, 1)} {
+		for _, s := range e.Sessions(d) {
+			if s.Contains(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsExtendedHours determines whether t is in the exchange's pre-market
+// or post-market session.
+func (e Exchange) IsExtendedHours(t time.Time) bool {
+	t = t.In(e.Location())
+	sessions := e.Sessions(t)
+	if len(sessions) == 0 {
+		return false
+	}
+
+	loc, d := e.Location(), gotime.DateOf(t)
+	if !e.PreMarket.IsZero() {
+		if gotime.NewInterval(e.PreMarket.Open.On(d, loc), sessions[0].Start.Time).Contains(t) {
+			return true
+		}
+	}
+	if !e.PostMarket.IsZero() {
+		if gotime.NewInterval(sessions[len(sessions)-1].End.Time, e.PostMarket.Close.On(d, loc)).Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen returns the next time after t that the regular session opens,
+// including reopening after a break, or the zero time if the exchange has
+// no sessions in the next year.
+func (e Exchange) NextOpen(t time.Time) time.Time {
+	return e.next(t, func(s gotime.Interval) time.Time { return s.Start.Time })
+}
+
+// NextClose returns the next time after t that the regular session closes,
+// including closing for a break, or the zero time if the exchange has no
+// sessions in the next year.
+func (e Exchange) NextClose(t time.Time) time.Time {
+	return e.next(t, func(s gotime.Interval) time.Time { return s.End.Time })
+}
+
+// next returns the first time after t that <at> returns for a session.
+func (e Exchange) next(t time.Time, at func(gotime.Interval) time.Time) time.Time {
+	t = t.In(e.Location())
+	day := utcDate(t)
+	for i := 0; i < maxClosedDays; i++ {
+		for _, s := range e.Sessions(day.AddDate(0, 0, i)) {
+			if at(s).After(t) {
+				return at(s)
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// SessionsBetween returns the regular sessions that open at or after <a>
+// and before <b>, in order.
+func (e Exchange) SessionsBetween(a, b time.Time) []gotime.Interval {
+	a, b = a.In(e.Location()), b.In(e.Location())
+	var res []gotime.Interval
+	for day := utcDate(a); !day.After(utcDate(b).AddDate(0, 0, 1)); day = day.AddDate(0, 0, 1) {
+		for _, s := range e.Sessions(day) {
+			if !s.Start.Before(a) && s.Start.Before(b) {
+				res = append(res, s)
+			}
+		}
+	}
+	return res
+}
+
+// utcDate returns midnight UTC on the date of t in its own location.
+func utcDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+var locations sync.Map
+
+// loadLocation returns the location with the given name, loading it the first time.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// mustLoadLocation is like loadLocation, but it panics if the location cannot be loaded.
+func mustLoadLocation(name string) *time.Location {
+	loc, err := loadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
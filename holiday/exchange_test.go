@@ -0,0 +1,160 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onwsk8r/gotime"
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("Exchange", func() {
+	load := func(name string) *time.Location {
+		loc, err := time.LoadLocation(name)
+		Expect(err).ToNot(HaveOccurred())
+		return loc
+	}
+	clock := func(hour, minute int) gotime.Clock {
+		return gotime.Clock{Hour: hour, Minute: minute}
+	}
+
+	Context("NYSE", func() {
+		var ny *time.Location
+		var at func(month time.Month, day, hour, minute int) time.Time
+		BeforeEach(func() {
+			ny = load("America/New_York")
+			at = func(month time.Month, day, hour, minute int) time.Time {
+				return time.Date(2018, month, day, hour, minute, 0, 0, ny)
+			}
+		})
+
+		It("should be open during the regular session", func() {
+			Expect(NYSE.IsOpen(at(time.July, 2, 9, 30))).To(BeTrue())
+			Expect(NYSE.IsOpen(at(time.July, 2, 15, 59))).To(BeTrue())
+			Expect(NYSE.IsOpen(at(time.July, 2, 9, 29))).To(BeFalse())
+			Expect(NYSE.IsOpen(at(time.July, 2, 16, 0))).To(BeFalse())
+		})
+
+		It("should be closed on weekends and holidays", func() {
+			Expect(NYSE.IsOpen(at(time.July, 1, 12, 0))).To(BeFalse()) // Sunday
+			Expect(NYSE.IsOpen(at(time.July, 4, 12, 0))).To(BeFalse())
+			Expect(NYSE.IsTradingDay(at(time.July, 4, 12, 0))).To(BeFalse())
+			Expect(NYSE.Sessions(at(time.July, 4, 0, 0))).To(BeEmpty())
+		})
+
+		It("should close early", func() {
+			Expect(NYSE.IsOpen(at(time.July, 3, 12, 59))).To(BeTrue())
+			Expect(NYSE.IsOpen(at(time.July, 3, 13, 30))).To(BeFalse())
+			Expect(NYSE.NextClose(at(time.July, 3, 10, 0))).To(BeTemporally("==", at(time.July, 3, 13, 0)))
+		})
+
+		It("should find the next open and close", func() {
+			Expect(NYSE.NextOpen(at(time.June, 29, 17, 0))).To(BeTemporally("==", at(time.July, 2, 9, 30)))
+			Expect(NYSE.NextOpen(at(time.July, 3, 9, 30))).To(BeTemporally("==", at(time.July, 5, 9, 30)))
+			Expect(NYSE.NextClose(at(time.July, 5, 16, 0))).To(BeTemporally("==", at(time.July, 6, 16, 0)))
+		})
+
+		It("should return times in New York", func() {
+			next := NYSE.NextOpen(time.Date(2018, time.July, 2, 13, 0, 0, 0, time.UTC))
+			Expect(next.Location()).To(Equal(ny))
+			Expect(next).To(BeTemporally("==", at(time.July, 2, 9, 30)))
+			Expect(NYSE.Location()).To(Equal(ny))
+		})
+
+		It("should list the sessions between two times", func() {
+			sessions := NYSE.SessionsBetween(at(time.July, 2, 0, 0), at(time.July, 7, 0, 0))
+			Expect(sessions).To(HaveLen(4))
+			Expect(sessions[0]).To(Equal(gotime.NewInterval(at(time.July, 2, 9, 30), at(time.July, 2, 16, 0))))
+			Expect(sessions[1]).To(Equal(gotime.NewInterval(at(time.July, 3, 9, 30), at(time.July, 3, 13, 0))))
+			Expect(sessions[2].Start.Time).To(BeTemporally("==", at(time.July, 5, 9, 30)))
+			Expect(sessions[3].Start.Time).To(BeTemporally("==", at(time.July, 6, 9, 30)))
+
+			Expect(NYSE.SessionsBetween(at(time.July, 2, 10, 0), at(time.July, 3, 9, 30))).To(BeEmpty())
+		})
+
+		It("should know the extended hours", func() {
+			Expect(NYSE.IsExtendedHours(at(time.July, 2, 4, 0))).To(BeTrue())
+			Expect(NYSE.IsExtendedHours(at(time.July, 2, 3, 59))).To(BeFalse())
+			Expect(NYSE.IsExtendedHours(at(time.July, 2, 12, 0))).To(BeFalse())
+			Expect(NYSE.IsExtendedHours(at(time.July, 2, 16, 0))).To(BeTrue())
+			Expect(NYSE.IsExtendedHours(at(time.July, 2, 20, 0))).To(BeFalse())
+			Expect(NYSE.IsExtendedHours(at(time.July, 3, 14, 0))).To(BeTrue())
+			Expect(NYSE.IsExtendedHours(at(time.July, 4, 10, 0))).To(BeFalse())
+		})
+
+		It("should keep the same hours as NASDAQ", func() {
+			t := at(time.November, 23, 12, 0)
+			Expect(NASDAQ.IsOpen(t)).To(Equal(NYSE.IsOpen(t)))
+			Expect(NASDAQ.NextClose(t)).To(Equal(NYSE.NextClose(t)))
+		})
+	})
+
+	Context("declared exchanges", func() {
+		It("should validate the time zone", func() {
+			Expect(NYSE.Validate()).To(Succeed())
+			lse := Exchange{Name: "LSE", Zone: "Europe/Londres"}
+			Expect(lse.Validate()).To(MatchError(ContainSubstring("Exchange LSE")))
+			Expect(func() { lse.IsOpen(time.Now()) }).To(Panic())
+		})
+
+		It("should close for lunch", func() {
+			tokyo := load("Asia/Tokyo")
+			tse := Exchange{
+				Name:     "TSE",
+				Zone:     "Asia/Tokyo",
+				Calendar: NewCalendar(List{{Finder: NYDay}}),
+				Regular:  []Hours{{clock(9, 0), clock(11, 30)}, {clock(12, 30), clock(15, 30)}},
+			}
+			at := func(hour, minute int) time.Time {
+				return time.Date(2018, time.July, 2, hour, minute, 0, 0, tokyo)
+			}
+
+			Expect(tse.IsOpen(at(10, 0))).To(BeTrue())
+			Expect(tse.IsOpen(at(12, 0))).To(BeFalse())
+			Expect(tse.IsOpen(at(13, 0))).To(BeTrue())
+			Expect(tse.NextClose(at(10, 0))).To(BeTemporally("==", at(11, 30)))
+			Expect(tse.NextOpen(at(11, 45))).To(BeTemporally("==", at(12, 30)))
+			Expect(tse.Sessions(at(0, 0))).To(HaveLen(2))
+			Expect(tse.IsExtendedHours(at(12, 0))).To(BeFalse())
+		})
+
+		It("should open the evening before", func() {
+			chicago := load("America/Chicago")
+			globex := Exchange{
+				Name:     "CME Globex",
+				Zone:     "America/Chicago",
+				Calendar: TradingCalendar,
+				Regular:  []Hours{{clock(17, 0), clock(16, 0)}},
+			}
+			at := func(day, hour, minute int) time.Time {
+				return time.Date(2018, time.July, day, hour, minute, 0, 0, chicago)
+			}
+
+			Expect(globex.IsOpen(at(1, 18, 0))).To(BeTrue()) // Sunday evening
+			Expect(globex.IsOpen(at(2, 15, 59))).To(BeTrue())
+			Expect(globex.IsOpen(at(2, 16, 30))).To(BeFalse())
+			Expect(globex.IsOpen(at(2, 17, 30))).To(BeTrue())
+			Expect(globex.IsOpen(at(3, 17, 30))).To(BeFalse()) // Independence Day
+			Expect(globex.IsOpen(at(6, 17, 30))).To(BeFalse()) // Friday evening
+			Expect(globex.NextOpen(at(3, 16, 30))).To(BeTemporally("==", at(4, 17, 0)))
+			Expect(globex.NextOpen(at(7, 12, 0))).To(BeTemporally("==", at(8, 17, 0)))
+			Expect(globex.Sessions(at(2, 0, 0))).To(Equal([]gotime.Interval{
+				gotime.NewInterval(at(1, 17, 0), at(2, 16, 0)),
+			}))
+		})
+
+		It("should use the time zone of the exchange", func() {
+			lse := Exchange{
+				Name:     "LSE",
+				Zone:     "Europe/London",
+				Calendar: NewCalendar(List{{Finder: NYDay}, {Finder: ChristmasDay}}),
+				Regular:  []Hours{{clock(8, 0), clock(16, 30)}},
+			}
+			Expect(lse.IsOpen(time.Date(2018, time.July, 2, 7, 30, 0, 0, time.UTC))).To(BeTrue()) // 8:30 BST
+			Expect(lse.IsOpen(time.Date(2018, time.July, 2, 15, 45, 0, 0, time.UTC))).To(BeFalse())
+			Expect(lse.IsOpen(time.Date(2018, time.December, 3, 7, 30, 0, 0, time.UTC))).To(BeFalse()) // 7:30 GMT
+		})
+	})
+})
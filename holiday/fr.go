@@ -0,0 +1,57 @@
+package holiday
+
+import (
+	"time"
+)
+
+// FranceHolidays are the public holidays (jours fériés) in France.
+// Alsace and Moselle also have Good Friday and St. Stephen's Day.
+var FranceHolidays = List{
+	frHoliday("new-years-day", "New Year's Day", "Jour de l'An", NYDay),
+	frHoliday("easter-monday", "Easter Monday", "Lundi de Pâques", EasterMonday),
+	frHoliday("labour-day", "Labour Day", "Fête du Travail", MayDay),
+	frHoliday("victory-day", "Victory in Europe Day", "Victoire 1945", VictoryInEuropeDay),
+	frHoliday("ascension-day", "Ascension Day", "Ascension", AscensionDay),
+	frHoliday("whit-monday", "Whit Monday", "Lundi de Pentecôte", WhitMonday),
+	frHoliday("bastille-day", "Bastille Day", "Fête nationale", BastilleDay),
+	frHoliday("assumption-day", "Assumption Day", "Assomption", AssumptionDay),
+	frHoliday("all-saints-day", "All Saints' Day", "Toussaint", AllSaintsDay),
+	frHoliday("armistice-day", "Armistice Day", "Armistice 1918", ArmisticeDay),
+	frHoliday("christmas-day", "Christmas Day", "Noël", ChristmasDay),
+}
+
+// frHoliday returns a French public holiday.
+func frHoliday(id, name, local string, f Finder) Holiday {
+	return euHoliday("FR", id, name, "fr", local, f)
+}
+
+// VictoryInEuropeDay returns the date of Victory in Europe Day in France.
+// It is May 8, and it was a public holiday from 1953 until 1959 and again
+// since 1982.
+func VictoryInEuropeDay(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < 1953 || (y > 1959 && y < 1982) {
+		return time.Time{}
+	}
+	return time.Date(y, time.May, 8, 0, 0, 0, 0, time.UTC)
+}
+
+// BastilleDay returns the date of Bastille Day, the French national day.
+// It is July 14 and has been a public holiday since 1880.
+func BastilleDay(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < 1880 {
+		return time.Time{}
+	}
+	return time.Date(y, time.July, 14, 0, 0, 0, 0, time.UTC)
+}
+
+// ArmisticeDay returns the date of Armistice Day in France.
+// It is Nov 11 and has been a public holiday since 1922.
+func ArmisticeDay(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < 1922 {
+		return time.Time{}
+	}
+	return time.Date(y, time.November, 11, 0, 0, 0, 0, time.UTC)
+}
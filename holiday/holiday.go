@@ -7,16 +7,47 @@ its own file. Good Friday lives in that file as well.
 
 The package is (currently, mainly) built around us.go, which contains functions to
 calculate holidays that are observed in the United States. It contains functions
-for calculating the 11 federal holidays: that's the 10 you know plus Inauguration
+for calculating the 12 federal holidays: that's the 11 you know plus Inauguration
 Day, which is observed only in DC to mitigate traffic. Each of those functions
-implements the Finder type.
+implements the Finder type. The functions follow the history of each holiday,
+such as Washington's Birthday being Feb 22 before 1971, and return the zero time
+for years before it was a holiday, so they can be used on historical data.
+The bank holidays of England and Wales, Scotland, and Northern Ireland are in uk.go,
+and the federal and provincial holidays of Canada, with their French names, in ca.go.
+The public holidays of Germany (by state), France, the Netherlands, Spain (by
+autonomous community), and Italy are in their own files, named for the country
+code, along with the TARGET2 calendar for euro settlement (eu.go). Many of them
+hang off Easter; easter.go has Ascension Day, Whit Monday, Corpus Christi, and
+the rest, and FromEaster for any other.
 
-The List type is an array of Finder functions and has functions to identify a given
-time.Time as a holiday or observed holiday. There are premade lists for US federal
-holidays (aka bank holidays or "days everyone else gets off") and trading holidays:
-days the stock markets are closed. More information about the federal and trading
-holidays can be found at <https://www.redcort.com/us-federal-bank-holidays> and
+A Holiday pairs a Finder with what a UI needs to show it: an ID, its names, where
+it is observed, and what kind of holiday it is. The List type is an array of
+Holidays and has functions to identify a given time.Time as a holiday or observed
+holiday, and to look up which holidays fall on or are observed on a date (see
+occurrence.go). There are premade lists for US federal holidays (aka bank holidays
+or "days everyone else gets off") and trading holidays: days the stock markets are
+closed, including one-off closures such as 9/11 (closures.go). Each Holiday can
+have its own rule for when it is observed if it falls on a weekend, and a List
+substitutes holidays that would be observed on the same day (observance.go).
+More information about the federal and trading holidays can be found at
+<https://www.redcort.com/us-federal-bank-holidays> and
 <https://www.nyse.com/markets/hours-calendars>.
+The markets also close early on a few days a year; see TradingEarlyCloses
+and EarlyCloseTime (early.go).
+
+Lists used to be arrays of Finders. This is a breaking change: a literal such
+as List{NYDay, ChristmasDay} is now NewList(NYDay, ChristmasDay), and code
+that ranges over a List gets each Holiday's Finder from its Finder field.
+
+The Calendar type (business.go) combines a List with a weekend to do arithmetic
+on business days, such as finding the settlement date of a trade two business
+days after it was made. TradingCalendar and FederalCalendar are ready to use.
+Calendars also apply the ISDA business day conventions (roll.go), such as
+Modified Following, when rolling the payment dates of a bond or swap.
+
+An Exchange (exchange.go) adds a time zone and trading hours to a Calendar to
+tell when a market is open, down to the minute. NYSE and NASDAQ are ready to
+use, and others can be declared with their own hours, breaks, and holidays.
 */
 package holiday
 
@@ -26,36 +57,49 @@ import (
 	"github.com/onwsk8r/gotime"
 )
 
-// TradingHolidays are days the US stock markets are closed.
+// TradingHolidays are days the US stock markets are closed, including the
+// one-off closures in TradingClosures. The markets began closing for MLK Day
+// in 1998 and Juneteenth in 2022, and closed on Election Day until 1980.
+// The list is meant to be accurate since 1963; before that the markets also
+// closed for days such as Lincoln's Birthday that it does not include.
 // This list does not include the days the markets close early: on July 3,
-// the day before Thanksgiving, and Christmas Eve the markets close at 1pm ET.
-var TradingHolidays List = []Finder{
-	NYDay,
-	MLKDay,
-	PresidentsDay,
-	GoodFriday,
-	MemorialDay,
-	IndependenceDay,
-	LaborDay,
-	Thanksgiving,
-	ChristmasDay,
-}
+// the day after Thanksgiving, and Christmas Eve the markets close at 1pm ET.
+// See TradingEarlyCloses for those. When a holiday falls on a weekend,
+// the markets observe it according to TradingObserved.
+var TradingHolidays = append(List{
+	{ID: "us-nyse-new-years-day", Name: "New Year's Day", Region: "US", Type: Market, Finder: NYDay, Rule: TradingObserved},
+	{ID: "us-nyse-mlk-day", Name: "Martin Luther King Jr. Day", Region: "US", Type: Market, Finder: Since(1998, MLKDay), Rule: TradingObserved},
+	{ID: "us-nyse-presidents-day", Name: "Washington's Birthday", Region: "US", Type: Market, Finder: PresidentsDay, Rule: TradingObserved},
+	{ID: "us-nyse-good-friday", Name: "Good Friday", Region: "US", Type: Market, Finder: GoodFriday, Rule: TradingObserved},
+	{ID: "us-nyse-memorial-day", Name: "Memorial Day", Region: "US", Type: Market, Finder: MemorialDay, Rule: TradingObserved},
+	{ID: "us-nyse-juneteenth", Name: "Juneteenth National Independence Day", Region: "US", Type: Market, Finder: Since(2022, Juneteenth), Rule: TradingObserved},
+	{ID: "us-nyse-independence-day", Name: "Independence Day", Region: "US", Type: Market, Finder: IndependenceDay, Rule: TradingObserved},
+	{ID: "us-nyse-labor-day", Name: "Labor Day", Region: "US", Type: Market, Finder: LaborDay, Rule: TradingObserved},
+	{ID: "us-nyse-election-day", Name: "Election Day", Region: "US", Type: Market, Finder: tradingElectionDay, Rule: TradingObserved},
+	{ID: "us-nyse-thanksgiving", Name: "Thanksgiving Day", Region: "US", Type: Market, Finder: Thanksgiving, Rule: TradingObserved},
+	{ID: "us-nyse-christmas-day", Name: "Christmas Day", Region: "US", Type: Market, Finder: ChristmasDay, Rule: TradingObserved},
+}, TradingClosures...)
 
 // FederalHolidays are days the US government takes off.
 // The USPS and banks tend to observe these holidays as well. This
 // list does not include Inauguration Day, as it is only a holiday
-// under very specific circumstances.
-var FederalHolidays List = []Finder{
-	NYDay,
-	MLKDay,
-	PresidentsDay,
-	MemorialDay,
-	IndependenceDay,
-	LaborDay,
-	ColumbusDay,
-	VeteransDay,
-	Thanksgiving,
-	ChristmasDay,
+// under very specific circumstances. When a holiday falls on a weekend,
+// it is observed on the nearest weekday, even if that is in the year before.
+// Note that New Year's Day is no exception: when it falls on a Saturday,
+// FederalHolidays.Observes is true on Friday, Dec 31, and no longer on the
+// following Monday, as it was when every List used Observed.
+var FederalHolidays = List{
+	{ID: "us-new-years-day", Name: "New Year's Day", Region: "US", Type: Public, Finder: NYDay, Rule: NearestWeekday},
+	{ID: "us-mlk-day", Name: "Martin Luther King Jr. Day", Region: "US", Type: Public, Finder: MLKDay, Rule: NearestWeekday},
+	{ID: "us-presidents-day", Name: "Washington's Birthday", Region: "US", Type: Public, Finder: PresidentsDay, Rule: NearestWeekday},
+	{ID: "us-memorial-day", Name: "Memorial Day", Region: "US", Type: Public, Finder: MemorialDay, Rule: NearestWeekday},
+	{ID: "us-juneteenth", Name: "Juneteenth National Independence Day", Region: "US", Type: Public, Finder: Juneteenth, Rule: NearestWeekday},
+	{ID: "us-independence-day", Name: "Independence Day", Region: "US", Type: Public, Finder: IndependenceDay, Rule: NearestWeekday},
+	{ID: "us-labor-day", Name: "Labor Day", Region: "US", Type: Public, Finder: LaborDay, Rule: NearestWeekday},
+	{ID: "us-columbus-day", Name: "Columbus Day", Region: "US", Type: Public, Finder: ColumbusDay, Rule: NearestWeekday},
+	{ID: "us-veterans-day", Name: "Veterans Day", Region: "US", Type: Public, Finder: VeteransDay, Rule: NearestWeekday},
+	{ID: "us-thanksgiving", Name: "Thanksgiving Day", Region: "US", Type: Public, Finder: Thanksgiving, Rule: NearestWeekday},
+	{ID: "us-christmas-day", Name: "Christmas Day", Region: "US", Type: Public, Finder: ChristmasDay, Rule: NearestWeekday},
 }
 
 // Finder is an interface for holiday calculation functions. Each function
@@ -63,11 +107,99 @@ var FederalHolidays List = []Finder{
 // date of the holiday. If a parameter is not specified, the function
 // should calculate the date of the holiday for the current year.
 // The returned time should be in local time (ie time.Local) with
-// zeroes for hours, minutes, seconds, and nanoseconds.
+// zeroes for hours, minutes, seconds, and nanoseconds. If there is no
+// such holiday in the year, eg because it did not exist yet, the function
+// should return the zero time.
 type Finder func(year ...int) time.Time
 
-// List represents a list of holiday Finders
-type List []Finder
+// Type is the kind of a holiday: who takes the day off, if anyone.
+type Type int
+
+const (
+	// Public holidays are days off required by law, such as Federal holidays.
+	Public Type = iota
+	// Bank holidays are days the banks are closed.
+	Bank
+	// Market holidays are days an exchange is closed.
+	Market
+	// Observance holidays are marked on the calendar but are not days off,
+	// such as Christmas Eve.
+	Observance
+)
+
+var typeNames = []string{"public", "bank", "market", "observance"}
+
+// String returns the name of the type, eg "public".
+func (t Type) String() string {
+	if t < 0 || int(t) >= len(typeNames) {
+		return "unknown"
+	}
+	return typeNames[t]
+}
+
+// ObservanceRule returns the date a holiday is observed given the date it
+// falls on, such as the Monday after a holiday that falls on a Sunday.
+// Observed is the default; see observance.go for other common rules.
+type ObservanceRule func(date time.Time) time.Time
+
+// Holiday describes a holiday: what it is called, where and how it is
+// observed, and the Finder to calculate its date.
+type Holiday struct {
+	ID     string            // A unique, stable identifier, eg "us-thanksgiving"
+	Name   string            // The official name in English
+	Names  map[string]string // The official name in other languages, by language tag (eg "fr")
+	Region string            // The ISO 3166 code of where it is observed, eg "US" or "US-DC"
+	Type   Type
+	Finder Finder
+
+	// Rule is how the holiday is observed when it falls on a weekend.
+	// If it is nil, the holiday is observed according to Observed.
+	// Check and Lookup also substitute holidays observed on the same day.
+	Rule ObservanceRule
+}
+
+// LocalName returns the name of the holiday in the language with the given
+// tag, or the English name if it does not have one in that language.
+func (h Holiday) LocalName(lang string) string {
+	if name, ok := h.Names[lang]; ok {
+		return name
+	}
+	return h.Name
+}
+
+// Observe returns the date the holiday is observed given the date it falls on.
+func (h Holiday) Observe(date time.Time) time.Time {
+	if h.Rule == nil {
+		return Observed(date)
+	}
+	return h.Rule(date)
+}
+
+// List represents a list of holidays. A List of bare Finders, as Lists used
+// to be, can be made with NewList.
+type List []Holiday
+
+// NewList creates a List from Finders, for holidays that need no names.
+// Each holiday is observed according to Observed. It replaces List literals
+// of Finders, such as List{NYDay}, from before List held Holidays.
+func NewList(finders ...Finder) List {
+	res := make(List, len(finders))
+	for i, f := range finders {
+		res[i] = Holiday{Finder: f}
+	}
+	return res
+}
+
+// Since returns a Finder for a holiday that is only observed from the
+// year <first>: before then, it returns the zero time.
+func Since(first int, f Finder) Finder {
+	return func(year ...int) time.Time {
+		if parseYear(year...) < first {
+			return time.Time{}
+		}
+		return f(year...)
+	}
+}
 
 // Contains checks if <date> exists in the List.
 func (l *List) Contains(date time.Time) bool {
@@ -84,7 +216,8 @@ func (l *List) Observes(date time.Time) bool {
 // preceeding Friday, and if it falls on a Sunday it is observed
 // the following Monday. The only exception to this rule is New
 // Year's Day, which is observed the following Monday when it
-// occurs on a Saturday.
+// occurs on a Saturday. It is the rule for holidays without a Rule of
+// their own; FederalHolidays and TradingHolidays each have their own.
 func Observed(holiday time.Time) time.Time {
 	// NYE Exception
 	if holiday.Day() == 1 && holiday.Month() == time.January &&
@@ -100,12 +233,15 @@ func Observed(holiday time.Time) time.Time {
 	return holiday
 }
 
-// Check whether the given date is a work holiday
+// Check whether the given date is a work holiday. Each holiday is observed
+// according to its own Rule, and holidays that would be observed on the same
+// day are substituted as described in observance.go.
 func Check(date time.Time, against *List) bool {
 	y := date.Year()
 
-	for _, holiday := range *against {
-		if gotime.DateEquals(date, Observed(holiday(y))) {
+	// A holiday can be observed in the year before or after it falls
+	for _, o := range against.occurrences(y-1, y+1) {
+		if gotime.DateEquals(date, o.Observed) {
 			return true
 		}
 	}
@@ -119,7 +255,7 @@ func CheckExact(date time.Time, against *List) bool {
 	y := date.Year()
 
 	for _, holiday := range *against {
-		if gotime.DateEquals(date, holiday(y)) {
+		if h := holiday.Finder(y); !h.IsZero() && gotime.DateEquals(date, h) {
 			return true
 		}
 	}
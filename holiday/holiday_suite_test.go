@@ -2,6 +2,7 @@ package holiday_test
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -11,3 +12,8 @@ func TestHoliday(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Holiday Suite")
 }
+
+// date returns midnight UTC on a date.
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
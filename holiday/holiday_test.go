@@ -25,5 +25,14 @@ var _ = Describe("Holiday", func() {
 			theDay, _ := time.Parse("20060102", "20180101")
 			Expect(FederalHolidays.Observes(theDay)).To(BeTrue())
 		})
+
+		It("should be made from Finders", func() {
+			list := NewList(NYDay, IndependenceDay)
+			Expect(list).To(HaveLen(2))
+			Expect(list.Contains(time.Date(2018, time.July, 4, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+			// July 4, 2020 was a Saturday
+			Expect(list.Observes(time.Date(2020, time.July, 3, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+			Expect(list.Observes(time.Date(2020, time.July, 6, 0, 0, 0, 0, time.UTC))).To(BeFalse())
+		})
 	})
 })
@@ -0,0 +1,52 @@
+package holiday
+
+import (
+	"time"
+
+	"github.com/onwsk8r/gotime"
+)
+
+// ItalyHolidays are the public holidays in Italy. Each city also has a
+// holiday for its patron saint, eg June 24 in Florence; those are not included.
+var ItalyHolidays = List{
+	itHoliday("new-years-day", "New Year's Day", "Capodanno", NYDay),
+	itHoliday("epiphany", "Epiphany", "Epifania", italianEpiphany),
+	itHoliday("easter-monday", "Easter Monday", "Lunedì dell'Angelo", EasterMonday),
+	itHoliday("liberation-day", "Liberation Day", "Festa della Liberazione", Since(1946, Fixed(time.April, 25))),
+	itHoliday("labour-day", "Labour Day", "Festa del Lavoro", MayDay),
+	itHoliday("republic-day", "Republic Day", "Festa della Repubblica", RepublicDay),
+	itHoliday("assumption-day", "Assumption Day", "Ferragosto", AssumptionDay),
+	itHoliday("all-saints-day", "All Saints' Day", "Ognissanti", AllSaintsDay),
+	itHoliday("immaculate-conception", "Immaculate Conception", "Immacolata Concezione", ImmaculateConception),
+	itHoliday("christmas-day", "Christmas Day", "Natale", ChristmasDay),
+	itHoliday("st-stephens-day", "St. Stephen's Day", "Santo Stefano", BoxingDay),
+}
+
+// itHoliday returns an Italian public holiday.
+func itHoliday(id, name, local string, f Finder) Holiday {
+	return euHoliday("IT", id, name, "it", local, f)
+}
+
+// italianEpiphany returns the date of the Epiphany in the years it was a public
+// holiday in Italy: every year except from 1977 until 1985, when it was
+// abolished by the same law that moved Republic Day.
+func italianEpiphany(year ...int) time.Time {
+	if y := parseYear(year...); y >= 1977 && y <= 1985 {
+		return time.Time{}
+	}
+	return Epiphany(year...)
+}
+
+// RepublicDay returns the date of Republic Day (Festa della Repubblica) in
+// Italy. It is June 2, except from 1977 until 2000, when it was moved to the
+// first Sunday in June.
+func RepublicDay(year ...int) time.Time {
+	y := parseYear(year...)
+	switch {
+	case y < 1947:
+		return time.Time{}
+	case y >= 1977 && y <= 2000:
+		return gotime.FirstWeekday(y, time.June, time.Sunday)
+	}
+	return time.Date(y, time.June, 2, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,50 @@
+package holiday
+
+import (
+	"time"
+)
+
+// NetherlandsHolidays are the public holidays in the Netherlands.
+// Liberation Day, May 5, is a day off only for some, mostly every fifth
+// year, so it is not included.
+var NetherlandsHolidays = List{
+	nlHoliday("new-years-day", "New Year's Day", "Nieuwjaarsdag", NYDay),
+	nlHoliday("easter-sunday", "Easter Sunday", "Eerste Paasdag", Easter),
+	nlHoliday("easter-monday", "Easter Monday", "Tweede Paasdag", EasterMonday),
+	nlHoliday("kings-day", "King's Day", "Koningsdag", KingsDay),
+	nlHoliday("ascension-day", "Ascension Day", "Hemelvaartsdag", AscensionDay),
+	nlHoliday("whit-sunday", "Whit Sunday", "Eerste Pinksterdag", WhitSunday),
+	nlHoliday("whit-monday", "Whit Monday", "Tweede Pinksterdag", WhitMonday),
+	nlHoliday("christmas-day", "Christmas Day", "Eerste Kerstdag", ChristmasDay),
+	nlHoliday("boxing-day", "Boxing Day", "Tweede Kerstdag", BoxingDay),
+}
+
+// nlHoliday returns a Dutch public holiday.
+func nlHoliday(id, name, local string, f Finder) Holiday {
+	return euHoliday("NL", id, name, "nl", local, f)
+}
+
+// KingsDay returns the date of King's Day (Koningsdag), the Dutch monarch's
+// birthday. It has been April 27 since 2014, or the 26th when the 27th is a
+// Sunday. From 1949 until 2013 it was Queen's Day, April 30; when that was a
+// Sunday, it moved to May 1 until 1979 and to April 29 from 1980.
+func KingsDay(year ...int) time.Time {
+	y := parseYear(year...)
+	switch {
+	case y < 1949:
+		return time.Time{}
+	case y < 2014:
+		t := time.Date(y, time.April, 30, 0, 0, 0, 0, time.UTC)
+		switch {
+		case t.Weekday() != time.Sunday:
+			return t
+		case y < 1980:
+			return t.AddDate(0, 0, 1)
+		}
+		return t.AddDate(0, 0, -1)
+	}
+	if t := time.Date(y, time.April, 27, 0, 0, 0, 0, time.UTC); t.Weekday() != time.Sunday {
+		return t
+	}
+	return time.Date(y, time.April, 26, 0, 0, 0, 0, time.UTC)
+}
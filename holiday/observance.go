@@ -0,0 +1,100 @@
+package holiday
+
+import (
+	"time"
+)
+
+// Each of the rules below is an ObservanceRule for holidays that fall on
+// a weekend. A List applies each holiday's own rule, then moves a holiday
+// that would be observed on the same day as another one to the next
+// weekday in the same direction: in the UK, when Christmas falls on a
+// Saturday, it is observed on Monday the 27th and Boxing Day, which falls
+// on Sunday, is observed on Tuesday the 28th.
+
+// NoObservance is the rule for holidays that are not observed on another
+// day when they fall on a weekend.
+func NoObservance(date time.Time) time.Time {
+	return date
+}
+
+// NearestWeekday is the rule for holidays that are observed on the Friday
+// before when they fall on a Saturday, and the Monday after when they fall
+// on a Sunday. It is the same as Observed without the New Year's exception.
+func NearestWeekday(date time.Time) time.Time {
+	switch date.Weekday() {
+	case time.Saturday:
+		return date.AddDate(0, 0, -1)
+	case time.Sunday:
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// SundayToMonday is the rule for holidays that are observed on the Monday
+// after when they fall on a Sunday, and not at all when they fall on a
+// Saturday.
+func SundayToMonday(date time.Time) time.Time {
+	if date.Weekday() == time.Sunday {
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// FollowingMonday is the rule for holidays that are observed on the Monday
+// after when they fall on a weekend, such as UK bank holidays.
+func FollowingMonday(date time.Time) time.Time {
+	switch date.Weekday() {
+	case time.Saturday:
+		return date.AddDate(0, 0, 2)
+	case time.Sunday:
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// TradingObserved is the rule for US stock market holidays. It is the same
+// as NearestWeekday, except that the markets do not close on a Friday that
+// ends a month: when New Year's Day falls on a Saturday, it is not observed.
+func TradingObserved(date time.Time) time.Time {
+	if date.Weekday() == time.Saturday && date.Day() == 1 {
+		return date
+	}
+	return NearestWeekday(date)
+}
+
+// substitute moves the observed dates of the occurrences so that no two
+// holidays that are observed on another day share a date. A moved holiday
+// that lands on a day another holiday falls on or is already observed on
+// moves on to the next weekday in the same direction.
+func substitute(occurrences []Occurrence) {
+	taken := make(map[time.Time]bool)
+	day := func(t time.Time) time.Time {
+		y, m, d := t.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	for _, o := range occurrences {
+		taken[day(o.Date)] = true
+	}
+
+	for i, o := range occurrences {
+		if !o.IsObserved() {
+			continue
+		}
+		if taken[day(o.Observed)] {
+			step := 1
+			if o.Observed.Before(o.Date) {
+				step = -1
+			}
+			for taken[day(o.Observed)] || isWeekend(o.Observed) {
+				o.Observed = o.Observed.AddDate(0, 0, step)
+			}
+			occurrences[i] = o
+		}
+		taken[day(o.Observed)] = true
+	}
+}
+
+// isWeekend determines whether t is a Saturday or Sunday.
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
@@ -0,0 +1,79 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("Observance rules", func() {
+	sat, sun, mon := date(2018, time.July, 7), date(2018, time.July, 8), date(2018, time.July, 9)
+
+	It("should move weekend holidays", func() {
+		Expect(NoObservance(sat)).To(Equal(sat))
+		Expect(NoObservance(sun)).To(Equal(sun))
+		Expect(NearestWeekday(sat)).To(Equal(date(2018, time.July, 6)))
+		Expect(NearestWeekday(sun)).To(Equal(mon))
+		Expect(SundayToMonday(sat)).To(Equal(sat))
+		Expect(SundayToMonday(sun)).To(Equal(mon))
+		Expect(FollowingMonday(sat)).To(Equal(mon))
+		Expect(FollowingMonday(sun)).To(Equal(mon))
+		for _, rule := range []ObservanceRule{NoObservance, NearestWeekday, SundayToMonday, FollowingMonday, TradingObserved} {
+			Expect(rule(mon)).To(Equal(mon))
+		}
+	})
+
+	It("should not close the markets on New Year's Eve", func() {
+		Expect(TradingObserved(date(2022, time.January, 1))).To(Equal(date(2022, time.January, 1)))
+		Expect(TradingObserved(date(2021, time.December, 25))).To(Equal(date(2021, time.December, 24)))
+		Expect(TradingCalendar.IsBusinessDay(date(2021, time.December, 31))).To(BeTrue())
+		Expect(TradingCalendar.IsBusinessDay(date(2021, time.December, 24))).To(BeFalse())
+		Expect(TradingCalendar.IsBusinessDay(date(2022, time.January, 3))).To(BeTrue())
+	})
+
+	It("should observe Federal holidays in the year before", func() {
+		Expect(FederalCalendar.IsBusinessDay(date(2021, time.December, 31))).To(BeFalse())
+		Expect(FederalCalendar.IsBusinessDay(date(2022, time.January, 3))).To(BeTrue())
+		res := FederalHolidays.Lookup(date(2021, time.December, 31))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].String()).To(Equal("New Year's Day (observed)"))
+	})
+
+	It("should use each holiday's own rule", func() {
+		list := List{
+			{Name: "Independence Day", Finder: IndependenceDay, Rule: NoObservance},
+			{Name: "Christmas Day", Finder: ChristmasDay},
+		}
+		Expect(list.Observes(date(2021, time.July, 5))).To(BeFalse())
+		Expect(list.Observes(date(2021, time.July, 4))).To(BeTrue())
+		Expect(list.Observes(date(2022, time.December, 26))).To(BeTrue())
+	})
+
+	It("should substitute holidays observed on the same day", func() {
+		boxingDay := func(year ...int) time.Time {
+			return ChristmasDay(year...).AddDate(0, 0, 1)
+		}
+		list := List{
+			{Name: "Christmas Day", Finder: ChristmasDay, Rule: FollowingMonday},
+			{Name: "Boxing Day", Finder: boxingDay, Rule: FollowingMonday},
+		}
+
+		// Christmas on Saturday
+		Expect(list.Observes(date(2021, time.December, 27))).To(BeTrue())
+		Expect(list.Observes(date(2021, time.December, 28))).To(BeTrue())
+		res := list.Lookup(date(2021, time.December, 28))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].String()).To(Equal("Boxing Day (observed)"))
+
+		// Christmas on Sunday
+		Expect(list.Observes(date(2022, time.December, 26))).To(BeTrue())
+		Expect(list.Observes(date(2022, time.December, 27))).To(BeTrue())
+		res = list.Lookup(date(2022, time.December, 27))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].String()).To(Equal("Christmas Day (observed)"))
+		Expect(list.Observes(date(2022, time.December, 28))).To(BeFalse())
+	})
+})
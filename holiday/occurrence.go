@@ -0,0 +1,94 @@
+package holiday
+
+import (
+	"sort"
+	"time"
+
+	"github.com/onwsk8r/gotime"
+)
+
+// Occurrence is a holiday in a particular year: the date it falls on,
+// and the date it is observed.
+type Occurrence struct {
+	Holiday  Holiday
+	Date     time.Time
+	Observed time.Time
+}
+
+// IsObserved determines whether the holiday is observed on a date other
+// than the one it falls on.
+func (o Occurrence) IsObserved() bool {
+	return !gotime.DateEquals(o.Date, o.Observed)
+}
+
+// String returns the name of the holiday, followed by "(observed)" if it
+// is observed on another date, eg "Thanksgiving Day" or
+// "Independence Day (observed)".
+func (o Occurrence) String() string {
+	if o.IsObserved() {
+		return o.Holiday.Name + " (observed)"
+	}
+	return o.Holiday.Name
+}
+
+// Lookup returns the holidays in the List that fall on or are observed
+// on <date>, in the order they appear in the List. Compare <date> to each
+// Occurrence's Date and Observed to tell which it is.
+func (l *List) Lookup(date time.Time) []Occurrence {
+	var res []Occurrence
+	// A holiday can be observed in the year before or after it falls
+	for _, o := range l.occurrences(date.Year()-1, date.Year()+1) {
+		if gotime.DateEquals(date, o.Date) || gotime.DateEquals(date, o.Observed) {
+			res = append(res, o)
+		}
+	}
+	return res
+}
+
+// Between returns the holidays in the List that fall on or are observed on
+// a date from <a> to <b>, inclusive, in order of the date they fall on.
+func (l *List) Between(a, b time.Time) []Occurrence {
+	first, last := gotime.DateOf(a), gotime.DateOf(b)
+	in := func(t time.Time) bool {
+		d := gotime.DateOf(t)
+		return !d.Before(first) && !d.After(last)
+	}
+
+	var res []Occurrence
+	for _, o := range l.occurrences(a.Year()-1, b.Year()+1) {
+		if in(o.Date) || in(o.Observed) {
+			res = append(res, o)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return gotime.DateOf(res[i].Date).Before(gotime.DateOf(res[j].Date))
+	})
+	return res
+}
+
+// occurrences returns the occurrences of the holidays in the List in each
+// year from <first> to <last>, inclusive, by year and then in List order.
+// A Finder like InaugurationDay can return the same date for several years;
+// it occurs only once. Holidays observed on the same date are substituted.
+func (l *List) occurrences(first, last int) []Occurrence {
+	type key struct {
+		idx  int
+		date gotime.Date
+	}
+	seen := make(map[key]bool)
+
+	var res []Occurrence
+	for y := first; y <= last; y++ {
+		for i, h := range *l {
+			date := h.Finder(y)
+			k := key{i, gotime.DateOf(date)}
+			if date.IsZero() || seen[k] {
+				continue
+			}
+			seen[k] = true
+			res = append(res, Occurrence{Holiday: h, Date: date, Observed: h.Observe(date)})
+		}
+	}
+	substitute(res)
+	return res
+}
@@ -0,0 +1,81 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("Occurrence", func() {
+	It("should look up a holiday by date", func() {
+		res := FederalHolidays.Lookup(date(2018, time.November, 22))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Holiday.ID).To(Equal("us-thanksgiving"))
+		Expect(res[0].Holiday.Type).To(Equal(Public))
+		Expect(res[0].IsObserved()).To(BeFalse())
+		Expect(res[0].String()).To(Equal("Thanksgiving Day"))
+
+		Expect(FederalHolidays.Lookup(date(2018, time.November, 21))).To(BeEmpty())
+	})
+
+	It("should tell the observed date from the actual one", func() {
+		res := FederalHolidays.Lookup(date(2020, time.July, 3))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Date).To(Equal(date(2020, time.July, 4)))
+		Expect(res[0].Observed).To(Equal(date(2020, time.July, 3)))
+		Expect(res[0].String()).To(Equal("Independence Day (observed)"))
+
+		res = FederalHolidays.Lookup(date(2020, time.July, 4))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Holiday.ID).To(Equal("us-independence-day"))
+	})
+
+	It("should find holidays observed in another year", func() {
+		list := List{{Name: "New Year's Day", Finder: NYDay, Rule: func(d time.Time) time.Time {
+			return d.AddDate(0, 0, -1)
+		}}}
+		res := list.Lookup(date(2017, time.December, 31))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Date).To(Equal(date(2018, time.January, 1)))
+	})
+
+	It("should name one-off closures", func() {
+		res := TradingHolidays.Lookup(date(2001, time.September, 11))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Holiday.Name).To(Equal("September 11 attacks"))
+		Expect(res[0].Holiday.Type).To(Equal(Market))
+	})
+
+	It("should list the holidays between two dates", func() {
+		res := FederalHolidays.Between(date(2018, time.November, 1), date(2018, time.December, 31))
+		Expect(res).To(HaveLen(3))
+		Expect(res[0].Holiday.Name).To(Equal("Veterans Day"))
+		Expect(res[0].Observed).To(Equal(date(2018, time.November, 12)))
+		Expect(res[1].String()).To(Equal("Thanksgiving Day"))
+		Expect(res[2].String()).To(Equal("Christmas Day"))
+
+		res = FederalHolidays.Between(date(2018, time.November, 12), date(2018, time.November, 12))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].String()).To(Equal("Veterans Day (observed)"))
+	})
+
+	It("should list a holiday once", func() {
+		list := List{{Name: "Inauguration Day", Finder: InaugurationDay}}
+		Expect(list.Between(date(2016, time.January, 1), date(2021, time.December, 31))).To(HaveLen(2))
+	})
+
+	It("should name holidays in other languages", func() {
+		h := Holiday{Name: "Christmas Day", Names: map[string]string{"fr": "Noël"}}
+		Expect(h.LocalName("fr")).To(Equal("Noël"))
+		Expect(h.LocalName("de")).To(Equal("Christmas Day"))
+	})
+
+	It("should name the types of holiday", func() {
+		Expect(Public.String()).To(Equal("public"))
+		Expect(Observance.String()).To(Equal("observance"))
+		Expect(Type(9).String()).To(Equal("unknown"))
+	})
+})
@@ -0,0 +1,102 @@
+package holiday
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/onwsk8r/gotime"
+)
+
+// Convention is a business day convention from the ISDA definitions: how a
+// date that falls on a weekend or holiday is moved to a business day.
+type Convention int
+
+// The business day conventions.
+const (
+	// Unadjusted leaves the date alone.
+	Unadjusted Convention = iota
+	// Following moves the date to the next business day.
+	Following
+	// ModifiedFollowing moves the date to the next business day unless that
+	// is in the next month, in which case it moves to the previous one.
+	ModifiedFollowing
+	// Preceding moves the date to the previous business day.
+	Preceding
+	// ModifiedPreceding moves the date to the previous business day unless that
+	// is in the previous month, in which case it moves to the next one.
+	ModifiedPreceding
+)
+
+var conventions = []string{"Unadjusted", "Following", "ModifiedFollowing", "Preceding", "ModifiedPreceding"}
+
+// String returns the name of the convention, eg ModifiedFollowing.
+func (conv Convention) String() string {
+	if conv < Unadjusted || conv > ModifiedPreceding {
+		return "Convention(" + strconv.Itoa(int(conv)) + ")"
+	}
+	return conventions[conv]
+}
+
+// Adjust moves <date> to a business day according to the convention.
+// Business days are returned as is.
+func (c Calendar) Adjust(date time.Time, conv Convention) time.Time {
+	if conv == Unadjusted || c.IsBusinessDay(date) {
+		return date
+	}
+
+	switch conv {
+	case Following:
+		return c.NextBusinessDay(date)
+	case ModifiedFollowing:
+		if next := c.NextBusinessDay(date); next.Month() == date.Month() {
+			return next
+		}
+		return c.PrevBusinessDay(date)
+	case Preceding:
+		return c.PrevBusinessDay(date)
+	case ModifiedPreceding:
+		if prev := c.PrevBusinessDay(date); prev.Month() == date.Month() {
+			return prev
+		}
+		return c.NextBusinessDay(date)
+	}
+	return date
+}
+
+// EndOfMonth returns the last business day of the month <date> is in.
+func (c Calendar) EndOfMonth(date time.Time) time.Time {
+	last := date.AddDate(0, 1, -date.Day()) // Day zero of next month
+	return c.Adjust(last, Preceding)
+}
+
+// IsEndOfMonth determines whether <date> is the last business day of its month.
+func (c Calendar) IsEndOfMonth(date time.Time) bool {
+	return gotime.DateEquals(date, c.EndOfMonth(date))
+}
+
+// Roll returns the date <n> months after <start>, adjusted according to the
+// convention, as when generating the payment dates of a bond or swap. The day
+// of the month is that of the start date, or the last day of shorter months.
+//
+// If eom is true, the end-of-month rule applies: when the start date is the
+// last business day of its month, every rolled date is the last business day
+// of its month as well, so a schedule starting on February 28th continues on
+// March 31st rather than March 28th. With the Unadjusted convention the rule
+// applies to the last day of the month instead of the last business day.
+func (c Calendar) Roll(start time.Time, n int, conv Convention, eom bool) time.Time {
+	date := gotime.AddMonths(start, n)
+	if !eom {
+		return c.Adjust(date, conv)
+	}
+
+	if conv == Unadjusted {
+		if start.AddDate(0, 0, 1).Day() == 1 {
+			return date.AddDate(0, 1, -date.Day())
+		}
+		return date
+	}
+	if c.IsEndOfMonth(start) {
+		return c.EndOfMonth(date)
+	}
+	return c.Adjust(date, conv)
+}
@@ -0,0 +1,65 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("Convention", func() {
+	It("should leave business days alone", func() {
+		for conv := Unadjusted; conv <= ModifiedPreceding; conv++ {
+			Expect(TradingCalendar.Adjust(date(2018, time.July, 3), conv)).To(Equal(date(2018, time.July, 3)))
+		}
+	})
+
+	It("should adjust weekends and holidays", func() {
+		// Saturday, March 31st, 2018 follows Good Friday
+		sat := date(2018, time.March, 31)
+		Expect(TradingCalendar.Adjust(sat, Unadjusted)).To(Equal(sat))
+		Expect(TradingCalendar.Adjust(sat, Following)).To(Equal(date(2018, time.April, 2)))
+		Expect(TradingCalendar.Adjust(sat, ModifiedFollowing)).To(Equal(date(2018, time.March, 29)))
+		Expect(TradingCalendar.Adjust(sat, Preceding)).To(Equal(date(2018, time.March, 29)))
+		Expect(TradingCalendar.Adjust(sat, ModifiedPreceding)).To(Equal(date(2018, time.March, 29)))
+
+		// Sunday, July 1st, 2018
+		sun := date(2018, time.July, 1)
+		Expect(TradingCalendar.Adjust(sun, Following)).To(Equal(date(2018, time.July, 2)))
+		Expect(TradingCalendar.Adjust(sun, ModifiedFollowing)).To(Equal(date(2018, time.July, 2)))
+		Expect(TradingCalendar.Adjust(sun, Preceding)).To(Equal(date(2018, time.June, 29)))
+		Expect(TradingCalendar.Adjust(sun, ModifiedPreceding)).To(Equal(date(2018, time.July, 2)))
+	})
+
+	It("should find the end of the month", func() {
+		Expect(TradingCalendar.EndOfMonth(date(2018, time.March, 5))).To(Equal(date(2018, time.March, 29)))
+		Expect(TradingCalendar.IsEndOfMonth(date(2018, time.March, 29))).To(BeTrue())
+		Expect(TradingCalendar.IsEndOfMonth(date(2018, time.March, 31))).To(BeFalse())
+	})
+
+	It("should roll dates by months", func() {
+		start := date(2018, time.January, 31)
+		Expect(TradingCalendar.Roll(start, 1, Unadjusted, false)).To(Equal(date(2018, time.February, 28)))
+		Expect(TradingCalendar.Roll(start, 2, Following, false)).To(Equal(date(2018, time.April, 2)))
+		Expect(TradingCalendar.Roll(start, 2, ModifiedFollowing, false)).To(Equal(date(2018, time.March, 29)))
+		Expect(TradingCalendar.Roll(start, -1, Preceding, false)).To(Equal(date(2017, time.December, 29)))
+	})
+
+	It("should apply the end-of-month rule", func() {
+		// February 28th, 2018 was a Wednesday
+		start := date(2018, time.February, 28)
+		Expect(TradingCalendar.Roll(start, 1, ModifiedFollowing, false)).To(Equal(date(2018, time.March, 28)))
+		Expect(TradingCalendar.Roll(start, 1, ModifiedFollowing, true)).To(Equal(date(2018, time.March, 29)))
+		Expect(TradingCalendar.Roll(start, 3, Following, true)).To(Equal(date(2018, time.May, 31)))
+		Expect(TradingCalendar.Roll(start, 1, Unadjusted, true)).To(Equal(date(2018, time.March, 31)))
+		Expect(TradingCalendar.Roll(date(2018, time.February, 27), 1, Following, true)).
+			To(Equal(date(2018, time.March, 27)))
+	})
+
+	It("should have names", func() {
+		Expect(ModifiedFollowing.String()).To(Equal("ModifiedFollowing"))
+		Expect(Convention(9).String()).To(Equal("Convention(9)"))
+	})
+})
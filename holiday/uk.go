@@ -0,0 +1,187 @@
+package holiday
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/onwsk8r/gotime"
+)
+
+// EnglandWalesBankHolidays are the bank holidays in England and Wales.
+// When a bank holiday falls on a weekend, a substitute day is given the
+// following Monday, or Tuesday if Monday is already a bank holiday, as when
+// Christmas Day falls on a Saturday and Boxing Day on Sunday.
+var EnglandWalesBankHolidays = append(List{
+	ukBankHoliday("GB-EAW", "new-years-day", "New Year's Day", Since(1974, NYDay)),
+	ukBankHoliday("GB-EAW", "good-friday", "Good Friday", GoodFriday),
+	ukBankHoliday("GB-EAW", "easter-monday", "Easter Monday", EasterMonday),
+	ukBankHoliday("GB-EAW", "early-may", "Early May bank holiday", EarlyMayBankHoliday),
+	ukBankHoliday("GB-EAW", "spring", "Spring bank holiday", SpringBankHoliday),
+	ukBankHoliday("GB-EAW", "summer", "Summer bank holiday", SummerBankHoliday),
+	ukBankHoliday("GB-EAW", "christmas-day", "Christmas Day", ChristmasDay),
+	ukBankHoliday("GB-EAW", "boxing-day", "Boxing Day", BoxingDay),
+}, ukOneOffs("GB-EAW")...)
+
+// ScotlandBankHolidays are the bank holidays in Scotland, which has the 2nd
+// of January and St Andrew's Day but not Easter Monday, and whose summer
+// bank holiday is at the start of August.
+var ScotlandBankHolidays = append(List{
+	ukBankHoliday("GB-SCT", "new-years-day", "New Year's Day", NYDay),
+	ukBankHoliday("GB-SCT", "2nd-january", "2nd January", SecondJanuary),
+	ukBankHoliday("GB-SCT", "good-friday", "Good Friday", GoodFriday),
+	ukBankHoliday("GB-SCT", "early-may", "Early May bank holiday", EarlyMayBankHoliday),
+	ukBankHoliday("GB-SCT", "spring", "Spring bank holiday", SpringBankHoliday),
+	ukBankHoliday("GB-SCT", "summer", "Summer bank holiday", ScotlandSummerBankHoliday),
+	ukBankHoliday("GB-SCT", "st-andrews-day", "St Andrew's Day", StAndrewsDay),
+	ukBankHoliday("GB-SCT", "christmas-day", "Christmas Day", ChristmasDay),
+	ukBankHoliday("GB-SCT", "boxing-day", "Boxing Day", BoxingDay),
+}, ukOneOffs("GB-SCT")...)
+
+// NorthernIrelandBankHolidays are the bank holidays in Northern Ireland:
+// those of England and Wales plus St Patrick's Day and the Battle of the Boyne.
+var NorthernIrelandBankHolidays = append(List{
+	ukBankHoliday("GB-NIR", "new-years-day", "New Year's Day", Since(1974, NYDay)),
+	ukBankHoliday("GB-NIR", "st-patricks-day", "St Patrick's Day", StPatricksDay),
+	ukBankHoliday("GB-NIR", "good-friday", "Good Friday", GoodFriday),
+	ukBankHoliday("GB-NIR", "easter-monday", "Easter Monday", EasterMonday),
+	ukBankHoliday("GB-NIR", "early-may", "Early May bank holiday", EarlyMayBankHoliday),
+	ukBankHoliday("GB-NIR", "spring", "Spring bank holiday", SpringBankHoliday),
+	ukBankHoliday("GB-NIR", "battle-of-the-boyne", "Battle of the Boyne (Orangemen's Day)", BattleOfTheBoyne),
+	ukBankHoliday("GB-NIR", "summer", "Summer bank holiday", SummerBankHoliday),
+	ukBankHoliday("GB-NIR", "christmas-day", "Christmas Day", ChristmasDay),
+	ukBankHoliday("GB-NIR", "boxing-day", "Boxing Day", BoxingDay),
+}, ukOneOffs("GB-NIR")...)
+
+// ukOneOff is a bank holiday that was declared for a single year.
+type ukOneOff struct {
+	year     int
+	month    time.Month
+	day      int
+	id, name string
+}
+
+// ukOneOffList are the one-off UK bank holidays since 1977, for royal weddings,
+// jubilees, and the like. They were declared for the whole UK.
+var ukOneOffList = []ukOneOff{
+	{1977, time.June, 7, "silver-jubilee", "Silver Jubilee of Elizabeth II"},
+	{1981, time.July, 29, "royal-wedding", "Wedding of Prince Charles and Lady Diana Spencer"},
+	{1999, time.December, 31, "millennium", "Millennium Eve"},
+	{2002, time.June, 3, "golden-jubilee", "Golden Jubilee of Elizabeth II"},
+	{2011, time.April, 29, "royal-wedding", "Wedding of Prince William and Catherine Middleton"},
+	{2012, time.June, 5, "diamond-jubilee", "Diamond Jubilee of Elizabeth II"},
+	{2022, time.June, 3, "platinum-jubilee", "Platinum Jubilee of Elizabeth II"},
+	{2022, time.September, 19, "state-funeral", "State Funeral of Queen Elizabeth II"},
+	{2023, time.May, 8, "coronation", "Coronation of Charles III"},
+}
+
+// ukOneOffs returns the one-off bank holidays for a region.
+func ukOneOffs(region string) List {
+	var res List
+	for _, o := range ukOneOffList {
+		id := fmt.Sprintf("%d-%s", o.year, o.id)
+		res = append(res, ukBankHoliday(region, id, o.name, Once(o.year, o.month, o.day)))
+	}
+	return res
+}
+
+// ukBankHoliday returns a UK bank holiday, which is substituted the
+// following Monday when it falls on a weekend.
+func ukBankHoliday(region, id, name string, f Finder) Holiday {
+	return Holiday{
+		ID:     fmt.Sprintf("%s-%s", strings.ToLower(region), id),
+		Name:   name,
+		Region: region,
+		Type:   Bank,
+		Finder: f,
+		Rule:   FollowingMonday,
+	}
+}
+
+// SecondJanuary returns the date of the 2nd of January, a bank holiday in Scotland.
+func SecondJanuary(year ...int) time.Time {
+	return addDays(NYDay(year...), 1)
+}
+
+// EarlyMayBankHoliday returns the date of the Early May bank holiday.
+// It is the first Monday in May and has been a UK bank holiday since 1978.
+// It was moved to May 8 for the 50th and 75th anniversaries of VE Day,
+// in 1995 and 2020.
+func EarlyMayBankHoliday(year ...int) time.Time {
+	y := parseYear(year...)
+	switch {
+	case y < 1978:
+		return time.Time{}
+	case y == 1995 || y == 2020:
+		return time.Date(y, time.May, 8, 0, 0, 0, 0, time.UTC)
+	}
+	return gotime.FirstWeekday(y, time.May, time.Monday)
+}
+
+// SpringBankHoliday returns the date of the Spring bank holiday.
+// It is the last Monday in May and has been a UK bank holiday since 1971,
+// replacing Whit Monday. It was moved into June for the Silver, Golden,
+// Diamond, and Platinum Jubilees of Elizabeth II.
+func SpringBankHoliday(year ...int) time.Time {
+	y := parseYear(year...)
+	switch y {
+	case 1977:
+		return time.Date(y, time.June, 6, 0, 0, 0, 0, time.UTC)
+	case 2002, 2012:
+		return time.Date(y, time.June, 4, 0, 0, 0, 0, time.UTC)
+	case 2022:
+		return time.Date(y, time.June, 2, 0, 0, 0, 0, time.UTC)
+	}
+	if y < 1971 {
+		return time.Time{}
+	}
+	return gotime.LastWeekday(y, time.May, time.Monday)
+}
+
+// SummerBankHoliday returns the date of the Summer bank holiday in England,
+// Wales, and Northern Ireland. It has been the last Monday in August since
+// 1971; before that, it was the first Monday, as it still is in Scotland.
+func SummerBankHoliday(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < 1971 {
+		return ScotlandSummerBankHoliday(y)
+	}
+	return gotime.LastWeekday(y, time.August, time.Monday)
+}
+
+// ScotlandSummerBankHoliday returns the date of the Summer bank holiday in
+// Scotland. It is the first Monday in August.
+func ScotlandSummerBankHoliday(year ...int) time.Time {
+	y := parseYear(year...)
+	return gotime.FirstWeekday(y, time.August, time.Monday)
+}
+
+// BoxingDay returns the date of Boxing Day.
+// Boxing Day is the day after Christmas Day.
+func BoxingDay(year ...int) time.Time {
+	return addDays(ChristmasDay(year...), 1)
+}
+
+// StAndrewsDay returns the date of St Andrew's Day.
+// St Andrew's Day is Nov 30 and has been a bank holiday in Scotland since 2007.
+func StAndrewsDay(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < 2007 {
+		return time.Time{}
+	}
+	return time.Date(y, time.November, 30, 0, 0, 0, 0, time.UTC)
+}
+
+// StPatricksDay returns the date of St Patrick's Day.
+// St Patrick's Day is Mar 17 and a bank holiday in Northern Ireland.
+func StPatricksDay(year ...int) time.Time {
+	y := parseYear(year...)
+	return time.Date(y, time.March, 17, 0, 0, 0, 0, time.UTC)
+}
+
+// BattleOfTheBoyne returns the date of the Battle of the Boyne holiday,
+// aka Orangemen's Day. It is July 12 and a bank holiday in Northern Ireland.
+func BattleOfTheBoyne(year ...int) time.Time {
+	y := parseYear(year...)
+	return time.Date(y, time.July, 12, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,94 @@
+package holiday_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/holiday"
+)
+
+var _ = Describe("UK bank holidays", func() {
+	england := NewCalendar(EnglandWalesBankHolidays)
+	scotland := NewCalendar(ScotlandBankHolidays)
+	northernIreland := NewCalendar(NorthernIrelandBankHolidays)
+
+	It("should find the bank holidays", func() {
+		Expect(EasterMonday(2019).Format("20060102")).To(Equal("20190422"))
+		Expect(EarlyMayBankHoliday(2019).Format("20060102")).To(Equal("20190506"))
+		Expect(SpringBankHoliday(2019).Format("20060102")).To(Equal("20190527"))
+		Expect(SummerBankHoliday(2019).Format("20060102")).To(Equal("20190826"))
+		Expect(ScotlandSummerBankHoliday(2019).Format("20060102")).To(Equal("20190805"))
+		Expect(BoxingDay(2019).Format("20060102")).To(Equal("20191226"))
+		Expect(SecondJanuary(2019).Format("20060102")).To(Equal("20190102"))
+		Expect(StAndrewsDay(2019).Format("20060102")).To(Equal("20191130"))
+		Expect(StPatricksDay(2019).Format("20060102")).To(Equal("20190317"))
+		Expect(BattleOfTheBoyne(2019).Format("20060102")).To(Equal("20190712"))
+	})
+
+	It("should have eight bank holidays in England and Wales", func() {
+		res := EnglandWalesBankHolidays.Between(date(2019, time.January, 1), date(2019, time.December, 31))
+		Expect(res).To(HaveLen(8))
+		Expect(res[0].Holiday.ID).To(Equal("gb-eaw-new-years-day"))
+		Expect(res[0].Holiday.Type).To(Equal(Bank))
+	})
+
+	It("should substitute Christmas and Boxing Day", func() {
+		Expect(england.IsBusinessDay(date(2021, time.December, 27))).To(BeFalse())
+		Expect(england.IsBusinessDay(date(2021, time.December, 28))).To(BeFalse())
+		Expect(england.IsBusinessDay(date(2021, time.December, 29))).To(BeTrue())
+		Expect(england.IsBusinessDay(date(2022, time.December, 26))).To(BeFalse())
+		Expect(england.IsBusinessDay(date(2022, time.December, 27))).To(BeFalse())
+		Expect(england.IsBusinessDay(date(2021, time.December, 24))).To(BeTrue())
+	})
+
+	It("should move bank holidays for anniversaries and jubilees", func() {
+		Expect(england.IsBusinessDay(date(2020, time.May, 4))).To(BeTrue())
+		Expect(england.IsBusinessDay(date(2020, time.May, 8))).To(BeFalse())
+		Expect(england.IsBusinessDay(date(2022, time.May, 30))).To(BeTrue())
+		Expect(england.IsBusinessDay(date(2022, time.June, 2))).To(BeFalse())
+		Expect(england.IsBusinessDay(date(2022, time.June, 3))).To(BeFalse())
+	})
+
+	It("should have the one-off bank holidays", func() {
+		for _, d := range []time.Time{
+			date(1981, time.July, 29),
+			date(1999, time.December, 31),
+			date(2011, time.April, 29),
+			date(2022, time.September, 19),
+			date(2023, time.May, 8),
+		} {
+			Expect(england.IsBusinessDay(d)).To(BeFalse(), d.String())
+			Expect(scotland.IsBusinessDay(d)).To(BeFalse(), d.String())
+			Expect(northernIreland.IsBusinessDay(d)).To(BeFalse(), d.String())
+		}
+		res := EnglandWalesBankHolidays.Lookup(date(2011, time.April, 29))
+		Expect(res).To(HaveLen(1))
+		Expect(res[0].Holiday.Name).To(Equal("Wedding of Prince William and Catherine Middleton"))
+	})
+
+	It("should follow the history of the bank holidays", func() {
+		Expect(EarlyMayBankHoliday(1977).IsZero()).To(BeTrue())
+		Expect(SpringBankHoliday(1970).IsZero()).To(BeTrue())
+		Expect(SummerBankHoliday(1970).Format("20060102")).To(Equal("19700803"))
+		Expect(StAndrewsDay(2006).IsZero()).To(BeTrue())
+		Expect(england.IsBusinessDay(date(1973, time.January, 1))).To(BeTrue())
+	})
+
+	It("should have Scottish bank holidays", func() {
+		Expect(scotland.IsBusinessDay(date(2022, time.January, 3))).To(BeFalse())
+		Expect(scotland.IsBusinessDay(date(2022, time.January, 4))).To(BeFalse())
+		Expect(england.IsBusinessDay(date(2022, time.January, 4))).To(BeTrue())
+		Expect(scotland.IsBusinessDay(date(2021, time.August, 2))).To(BeFalse())
+		Expect(scotland.IsBusinessDay(date(2021, time.August, 30))).To(BeTrue())
+		Expect(scotland.IsBusinessDay(date(2021, time.April, 5))).To(BeTrue()) // Easter Monday
+		Expect(scotland.IsBusinessDay(date(2019, time.December, 2))).To(BeFalse())
+	})
+
+	It("should have Northern Irish bank holidays", func() {
+		Expect(northernIreland.IsBusinessDay(date(2019, time.March, 18))).To(BeFalse())
+		Expect(northernIreland.IsBusinessDay(date(2020, time.July, 13))).To(BeFalse())
+		Expect(england.IsBusinessDay(date(2020, time.July, 13))).To(BeTrue())
+	})
+})
@@ -6,10 +6,30 @@ import (
 	"github.com/onwsk8r/gotime"
 )
 
-// NYDay returns the date of New Year's Day.
-// If it falls on a weekend, it is observed the following Monday.
+// The years the holidays below became Federal holidays. Before them, the
+// finders return the zero time.
+const (
+	firstNYDay           = 1870
+	firstPresidentsDay   = 1879
+	firstMemorialDay     = 1888
+	firstLaborDay        = 1894
+	firstColumbusDay     = 1937
+	firstVeteransDay     = 1938
+	firstMLKDay          = 1986
+	firstJuneteenth      = 2021
+	uniformMondayHoliday = 1971 // The Uniform Monday Holiday Act took effect
+)
+
+// NYDay returns the date of New Year's Day. How it is observed when it falls
+// on a weekend depends on the List: a Saturday New Year's Day is observed on
+// Friday, Dec 31, in FederalHolidays, and not at all in TradingHolidays.
+// It has been a Federal holiday since 1870, as have Independence Day,
+// Thanksgiving, and Christmas Day.
 func NYDay(year ...int) time.Time {
 	y := parseYear(year...)
+	if y < firstNYDay {
+		return time.Time{}
+	}
 	return time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC)
 }
 
@@ -19,7 +39,8 @@ func NYDay(year ...int) time.Time {
 // return the date of the "next" one; these years can be represented
 // as (y % 4 == 1). If 2016 or 2017 is passed as a parameter, for
 // example, the function will return Jan 20, 2017. If 2018 is passed,
-// the function will return Jan 20, 2021.
+// the function will return Jan 20, 2021. Until 1937, presidents were
+// sworn in on March 4.
 func InaugurationDay(year ...int) time.Time {
 	y := parseYear(year...)
 	for {
@@ -28,27 +49,48 @@ func InaugurationDay(year ...int) time.Time {
 		}
 		y++
 	}
+	if y < 1937 {
+		return time.Date(y, time.March, 4, 0, 0, 0, 0, time.UTC)
+	}
 	return time.Date(y, 1, 20, 0, 0, 0, 0, time.UTC)
 }
 
 // MLKDay returns the date of Martin Luther King Day.
-// MLK Day is the third Monday in January and is a Federal holiday.
+// MLK Day is the third Monday in January and is a Federal holiday,
+// first observed in 1986.
 func MLKDay(year ...int) time.Time {
 	y := parseYear(year...)
+	if y < firstMLKDay {
+		return time.Time{}
+	}
 	return gotime.NthWeekday(y, time.January, 3, time.Monday)
 }
 
 // PresidentsDay returns the date of President's Day.
 // President's Day is the third Monday in February and a Federal holiday.
+// It was Washington's Birthday, Feb 22, from 1879 until 1971.
 func PresidentsDay(year ...int) time.Time {
 	y := parseYear(year...)
+	switch {
+	case y < firstPresidentsDay:
+		return time.Time{}
+	case y < uniformMondayHoliday:
+		return time.Date(y, time.February, 22, 0, 0, 0, 0, time.UTC)
+	}
 	return gotime.NthWeekday(y, time.February, 3, time.Monday)
 }
 
 // MemorialDay returns the date of Memorial Day.
 // Memorial Day is the last Monday in May and a Federal holiday.
+// It was Decoration Day, May 30, from 1888 until 1971.
 func MemorialDay(year ...int) time.Time {
 	y := parseYear(year...)
+	switch {
+	case y < firstMemorialDay:
+		return time.Time{}
+	case y < uniformMondayHoliday:
+		return time.Date(y, time.May, 30, 0, 0, 0, 0, time.UTC)
+	}
 	return gotime.LastWeekday(y, time.May, time.Monday)
 }
 
@@ -56,60 +98,128 @@ func MemorialDay(year ...int) time.Time {
 // Independence Day is aka July 4. It is not a Federal Holiday (just kidding!)
 func IndependenceDay(year ...int) time.Time {
 	y := parseYear(year...)
+	if y < firstNYDay {
+		return time.Time{}
+	}
 	return time.Date(y, time.July, 4, 0, 0, 0, 0, time.UTC)
 }
 
+// IndependenceDayEve returns the date of the day before Independence Day.
+// July 3 is not a holiday, but the stock markets close early when it is a trading day.
+func IndependenceDayEve(year ...int) time.Time {
+	return addDays(IndependenceDay(year...), -1)
+}
+
+// Juneteenth returns the date of Juneteenth National Independence Day.
+// Juneteenth is June 19 and has been a Federal holiday since 2021.
+func Juneteenth(year ...int) time.Time {
+	y := parseYear(year...)
+	if y < firstJuneteenth {
+		return time.Time{}
+	}
+	return time.Date(y, time.June, 19, 0, 0, 0, 0, time.UTC)
+}
+
 // LaborDay returns the date of Labor Day.
-// Labor Day is the first Monday in September and a Federal holiday.
+// Labor Day is the first Monday in September and a Federal holiday,
+// first observed in 1894.
 func LaborDay(year ...int) time.Time {
 	y := parseYear(year...)
+	if y < firstLaborDay {
+		return time.Time{}
+	}
 	return gotime.FirstWeekday(y, time.September, time.Monday)
 }
 
 // ColumbusDay returns the date of Columbus Day.
 // Columbus Day is the second Monday in October and a Federal holiday.
+// It was Oct 12 from 1937 until 1971.
 func ColumbusDay(year ...int) time.Time {
 	y := parseYear(year...)
+	switch {
+	case y < firstColumbusDay:
+		return time.Time{}
+	case y < uniformMondayHoliday:
+		return time.Date(y, time.October, 12, 0, 0, 0, 0, time.UTC)
+	}
 	return gotime.NthWeekday(y, time.October, 2, time.Monday)
 }
 
 // VeteransDay returns the date of Veteran's Day.
-// Veteran's Day is Nov 11, and a Federal holiday.
+// Veteran's Day is Nov 11, and a Federal holiday. It was Armistice Day
+// from 1938 until 1954, and it moved to the fourth Monday in October from
+// 1971 until 1978.
 func VeteransDay(year ...int) time.Time {
 	y := parseYear(year...)
+	switch {
+	case y < firstVeteransDay:
+		return time.Time{}
+	case y >= uniformMondayHoliday && y < 1978:
+		return gotime.NthWeekday(y, time.October, 4, time.Monday)
+	}
 	return time.Date(y, time.November, 11, 0, 0, 0, 0, time.UTC)
 }
 
 // Thanksgiving returns the date of Thanksgiving.
 // Thanksgiving is the fourth Thursday in November and a Federal holiday.
+// It was the last Thursday until 1939, when FDR moved it a week earlier
+// to lengthen the Christmas shopping season: from 1939 to 1941 it was the
+// second to last Thursday, and Congress fixed it to the fourth in 1942.
 func Thanksgiving(year ...int) time.Time {
 	y := parseYear(year...)
+	switch {
+	case y < firstNYDay:
+		return time.Time{}
+	case y < 1939:
+		return gotime.LastWeekday(y, time.November, time.Thursday)
+	case y < 1942:
+		return gotime.LastWeekday(y, time.November, time.Thursday).AddDate(0, 0, -7)
+	}
 	return gotime.NthWeekday(y, time.November, 4, time.Thursday)
 }
 
 // BlackFriday returns the date of Black Friday.
 // Black Friday is the day after Thanksgiving and might as well be a Federal holiday.
 func BlackFriday(year ...int) time.Time {
-	return Thanksgiving(year...).Add(24 * time.Hour)
+	return addDays(Thanksgiving(year...), 1)
 }
 
 // ChristmasDay returns the date of Christmas Day.
 // Christmas Day is Dec 25, and a Federal holiday.
 func ChristmasDay(year ...int) time.Time {
 	y := parseYear(year...)
+	if y < firstNYDay {
+		return time.Time{}
+	}
 	return time.Date(y, time.December, 25, 0, 0, 0, 0, time.UTC)
 }
 
 // ChristmasEve returns the date of Christmas Eve.
 // Christmas Eve is the day before Christmas (Day) and might as well be a Federal holiday.
 func ChristmasEve(year ...int) time.Time {
-	return ChristmasDay(year...).Add(-24 * time.Hour)
+	return addDays(ChristmasDay(year...), -1)
 }
 
 // NYEve returns the date of New Year's Eve.
 // New Year's Eve is the day before Christmas (Day) and might as well be a Federal holiday.
 func NYEve(year ...int) time.Time {
-	return NYDay(year...).Add(-24 * time.Hour)
+	return addDays(NYDay(year...), -1)
+}
+
+// ElectionDay returns the date of Election Day.
+// Election Day is the Tuesday after the first Monday in November. It is not
+// a Federal holiday, though the stock markets used to close for it.
+func ElectionDay(year ...int) time.Time {
+	y := parseYear(year...)
+	return gotime.FirstWeekday(y, time.November, time.Monday).AddDate(0, 0, 1)
+}
+
+// addDays adds days to the date of a holiday, unless there is no holiday.
+func addDays(date time.Time, days int) time.Time {
+	if date.IsZero() {
+		return date
+	}
+	return date.AddDate(0, 0, days)
 }
 
 // Parse year keeps the functions above DRY
@@ -1,6 +1,8 @@
 package holiday_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -71,4 +73,54 @@ var _ = Describe("US Holidays", func() {
 	It("Should find Christmas Day", func() {
 		Expect(ChristmasDay(year).Format("20060102")).To(Equal("20181225"))
 	})
+
+	It("Should find Juneteenth", func() {
+		Expect(Juneteenth(2021).Format("20060102")).To(Equal("20210619"))
+		Expect(Juneteenth(year).IsZero()).To(BeTrue())
+	})
+
+	It("Should find Election Day", func() {
+		Expect(ElectionDay(year).Format("20060102")).To(Equal("20181106"))
+		Expect(ElectionDay(2022).Format("20060102")).To(Equal("20221108"))
+	})
+
+	Context("history", func() {
+		It("should not find holidays before they existed", func() {
+			Expect(MLKDay(1950).IsZero()).To(BeTrue())
+			Expect(MLKDay(1986).Format("20060102")).To(Equal("19860120"))
+			Expect(ColumbusDay(1936).IsZero()).To(BeTrue())
+			Expect(LaborDay(1893).IsZero()).To(BeTrue())
+			Expect(NYDay(1869).IsZero()).To(BeTrue())
+			Expect(NYEve(1869).IsZero()).To(BeTrue())
+			Expect(ChristmasEve(1869).IsZero()).To(BeTrue())
+		})
+
+		It("should use the dates before the Uniform Monday Holiday Act", func() {
+			Expect(PresidentsDay(1970).Format("20060102")).To(Equal("19700222"))
+			Expect(MemorialDay(1970).Format("20060102")).To(Equal("19700530"))
+			Expect(ColumbusDay(1970).Format("20060102")).To(Equal("19701012"))
+			Expect(PresidentsDay(1971).Format("20060102")).To(Equal("19710215"))
+			Expect(MemorialDay(1971).Format("20060102")).To(Equal("19710531"))
+			Expect(ColumbusDay(1971).Format("20060102")).To(Equal("19711011"))
+		})
+
+		It("should move Veteran's Day to October in the 1970s", func() {
+			Expect(VeteransDay(1970).Format("20060102")).To(Equal("19701111"))
+			Expect(VeteransDay(1975).Format("20060102")).To(Equal("19751027"))
+			Expect(VeteransDay(1978).Format("20060102")).To(Equal("19781111"))
+		})
+
+		It("should find Franksgiving", func() {
+			Expect(Thanksgiving(1938).Format("20060102")).To(Equal("19381124"))
+			Expect(Thanksgiving(1939).Format("20060102")).To(Equal("19391123"))
+			Expect(Thanksgiving(1941).Format("20060102")).To(Equal("19411120"))
+			Expect(Thanksgiving(1942).Format("20060102")).To(Equal("19421126"))
+			Expect(BlackFriday(1939).Format("20060102")).To(Equal("19391124"))
+		})
+
+		It("should inaugurate presidents in March before 1937", func() {
+			Expect(InaugurationDay(1933)).To(Equal(time.Date(1933, time.March, 4, 0, 0, 0, 0, time.UTC)))
+			Expect(InaugurationDay(1934)).To(Equal(time.Date(1937, time.January, 20, 0, 0, 0, 0, time.UTC)))
+		})
+	})
 })
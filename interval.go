@@ -0,0 +1,230 @@
+package gotime
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Interval represents an ISO-8601 time interval: the time between Start,
+// inclusive, and End, exclusive. Start and End are Times so that an interval
+// that was parsed from a string can be formatted the same way again; an
+// interval that was written with a duration, such as 2018-07-01/P1M,
+// is resolved to its start and end when parsed.
+type Interval struct {
+	Start Time
+	End   Time
+}
+
+// NewInterval creates a new Interval between two time.Times.
+func NewInterval(start, end time.Time) Interval {
+	return Interval{Start: Time{Time: start}, End: Time{Time: end}}
+}
+
+// ParseInterval parses an ISO-8601 time interval in any of these forms:
+// start/end (2018-07-01/2018-07-14), start/duration (2018-07-01T09:00Z/PT8H),
+// or duration/end (P1M/2018-08-01). The end can be abbreviated by omitting
+// its leading components, which are then taken from the start (2018-07-01/14
+// or 2018-07-01T09:00Z/17:00); the end shares the start's offset unless it
+// has one of its own. Dates and times are parsed with ParseWithFormat and
+// durations with ParsePeriod.
+func ParseInterval(str string) (Interval, error) {
+	parts := strings.Split(str, "/")
+	if len(parts) != 2 {
+		return Interval{}, NewParseError(str, "Should have exactly one '/'")
+	}
+	a, b := parts[0], parts[1]
+
+	var i Interval
+	var err error
+	switch {
+	case isPeriod(a) && isPeriod(b):
+		return Interval{}, NewParseError(str, "Has two durations")
+	case isPeriod(a): // duration/end
+		var p Period
+		if p, err = ParsePeriod(a); err != nil {
+			return Interval{}, err
+		}
+		if i.End, err = parseTime(b); err != nil {
+			return Interval{}, err
+		}
+		i.Start = Time{Time: p.Negate().AddTo(i.End.Time)}
+	case isPeriod(b): // start/duration
+		var p Period
+		if p, err = ParsePeriod(b); err != nil {
+			return Interval{}, err
+		}
+		if i.Start, err = parseTime(a); err != nil {
+			return Interval{}, err
+		}
+		i.End = Time{Time: p.AddTo(i.Start.Time)}
+	default: // start/end
+		if i.Start, err = parseTime(a); err != nil {
+			return Interval{}, err
+		}
+		if i.End, err = parseEnd(a, b); err != nil {
+			return Interval{}, err
+		}
+	}
+
+	if i.End.Before(i.Start.Time) {
+		return Interval{}, NewParseError(str, "Ends before it starts")
+	}
+	return i, nil
+}
+
+// isPeriod determines whether one side of an interval is a duration.
+func isPeriod(str string) bool {
+	return strings.HasPrefix(strings.TrimLeft(str, "+-"), "P")
+}
+
+// parseTime parses one side of an interval, keeping its format.
+func parseTime(str string) (Time, error) {
+	t, format, err := ParseWithFormat(str)
+	return Time{Time: t, OriginalFormat: format}, err
+}
+
+// intervalZone matches the offset at the end of a time.
+var intervalZone = regexp.MustCompile(`(Z|[+-]\d{2}(?::?\d{2})?)$`)
+
+// splitZone splits a date and time into the part before its offset and the offset.
+// Only strings with times can have offsets: the hyphen in 07-14 is not one.
+func splitZone(str string) (string, string) {
+	if !strings.ContainsAny(str, "T:") {
+		return str, ""
+	}
+	if idx := intervalZone.FindStringIndex(str); idx != nil {
+		return str[:idx[0]], str[idx[0]:]
+	}
+	return str, ""
+}
+
+// fullYear matches an end that starts with a year and a date separator or
+// week designator, and so is not abbreviated.
+var fullYear = regexp.MustCompile(`^\d{4}[-W]`)
+
+// isComplete determines whether the end of a start/end interval is a complete
+// date and time rather than an abbreviated one. An end in basic format is
+// complete if its date is as long as the start's date would be in basic format.
+func isComplete(start, end string) bool {
+	if fullYear.MatchString(end) {
+		return true
+	}
+	startDate := strings.Replace(strings.SplitN(start, "T", 2)[0], "-", "", -1)
+	endBody, _ := splitZone(end)
+	return len(strings.SplitN(endBody, "T", 2)[0]) >= len(startDate)
+}
+
+// parseEnd parses the end of a start/end interval, which may be abbreviated.
+// A complete end is parsed as is, even if it is less precise than the start
+// (2018-07-01T09:00Z/2018-07-14). The format of an abbreviated end is
+// abbreviated to match.
+func parseEnd(start, end string) (Time, error) {
+	if isComplete(start, end) {
+		return parseTime(end)
+	}
+	startBody, startZone := splitZone(start)
+	endBody, endZone := splitZone(end)
+	if len(endBody) > len(startBody) {
+		return Time{}, NewParseError(end, "Abbreviated end is longer than the start")
+	}
+
+	zone := endZone
+	if zone == "" {
+		zone = startZone
+	}
+	full := startBody[:len(startBody)-len(endBody)] + endBody
+	t, err := parseTime(full + zone)
+	if err != nil {
+		return t, err
+	}
+
+	// Layouts are as wide as the strings they describe, up to the offset
+	format := t.OriginalFormat[len(full)-len(endBody) : len(full)]
+	if endZone != "" {
+		format += t.OriginalFormat[len(full):]
+	}
+	t.OriginalFormat = format
+	return t, nil
+}
+
+// String returns the interval in start/end format.
+func (i Interval) String() string {
+	start, _ := i.Start.MarshalText() // nolint: gosec, errcheck
+	end, _ := i.End.MarshalText()     // nolint: gosec, errcheck
+	return fmt.Sprintf("%s/%s", start, end)
+}
+
+// Duration returns the length of the interval.
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start.Time)
+}
+
+// Contains determines whether t is within the interval.
+// The start is included and the end is not.
+func (i Interval) Contains(t time.Time) bool {
+	return !t.Before(i.Start.Time) && t.Before(i.End.Time)
+}
+
+// Overlaps determines whether two intervals share any time.
+func (i Interval) Overlaps(other Interval) bool {
+	return i.Start.Before(other.End.Time) && other.Start.Before(i.End.Time)
+}
+
+// Intersect returns the time shared by two intervals. If they do not
+// overlap, the second return value is false.
+func (i Interval) Intersect(other Interval) (Interval, bool) {
+	if !i.Overlaps(other) {
+		return Interval{}, false
+	}
+	res := i
+	if other.Start.After(res.Start.Time) {
+		res.Start = other.Start
+	}
+	if other.End.Before(res.End.Time) {
+		res.End = other.End
+	}
+	return res, true
+}
+
+// Union returns the interval covering both intervals. If they neither overlap
+// nor touch, there is no such interval and the second return value is false.
+func (i Interval) Union(other Interval) (Interval, bool) {
+	if i.Start.After(other.End.Time) || other.Start.After(i.End.Time) {
+		return Interval{}, false
+	}
+	res := i
+	if other.Start.Before(res.Start.Time) {
+		res.Start = other.Start
+	}
+	if other.End.After(res.End.Time) {
+		res.End = other.End
+	}
+	return res, true
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (i Interval) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (i *Interval) UnmarshalText(data []byte) error {
+	res, err := ParseInterval(string(data))
+	if err != nil {
+		return err
+	}
+	*i = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (i Interval) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", i)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (i *Interval) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalText([]byte(strings.Trim(string(data), "\"")))
+}
@@ -0,0 +1,135 @@
+package gotime_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime"
+)
+
+var _ = Describe("Interval", func() {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	Describe("ParseInterval", func() {
+		It("should parse start/end", func() {
+			i, err := ParseInterval("2018-07-01/2018-07-14")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i.Start.Time).To(Equal(date(2018, time.July, 1)))
+			Expect(i.End.Time).To(Equal(date(2018, time.July, 14)))
+			Expect(i.Duration()).To(Equal(13 * 24 * time.Hour))
+		})
+
+		It("should parse start/duration", func() {
+			i, err := ParseInterval("2018-07-01T09:00:00Z/PT8H")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i.End.Time).To(Equal(time.Date(2018, time.July, 1, 17, 0, 0, 0, time.UTC)))
+		})
+
+		It("should parse duration/end", func() {
+			i, err := ParseInterval("P1M/2018-08-01")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i.Start.Time).To(Equal(date(2018, time.July, 1)))
+			Expect(i.String()).To(Equal("2018-07-01T00:00:00Z/2018-08-01"))
+		})
+
+		var abbreviated = map[string]time.Time{
+			"2018-07-01/14":                     date(2018, time.July, 14),
+			"2018-07-01/08-02":                  date(2018, time.August, 2),
+			"2018-07-01T09:00Z/17:00":           time.Date(2018, time.July, 1, 17, 0, 0, 0, time.UTC),
+			"2018-07-01T09:00+02:00/02T01:00":   time.Date(2018, time.July, 1, 23, 0, 0, 0, time.UTC),
+			"2018-07-01T09:00+02:00/17:00-0500": time.Date(2018, time.July, 1, 22, 0, 0, 0, time.UTC),
+			"20180701T0900/1700":                time.Date(2018, time.July, 1, 17, 0, 0, 0, time.UTC),
+			"20180701/0714":                     date(2018, time.July, 14),
+		}
+		for str, end := range abbreviated {
+			str, end := str, end
+			It("should parse and format the abbreviated end in "+str, func() {
+				i, err := ParseInterval(str)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(i.End.Time).To(BeTemporally("==", end))
+				Expect(i.String()).To(Equal(str))
+			})
+		}
+
+		var complete = map[string]time.Time{
+			"2018-07-01T09:00:00Z/2018-07-14T17:00Z":        time.Date(2018, time.July, 14, 17, 0, 0, 0, time.UTC),
+			"2018-07-01T09:00Z/2018-07-14":                  date(2018, time.July, 14),
+			"2018-07-01T09:00:00.123Z/2018-07-02T10:00:00Z": time.Date(2018, time.July, 2, 10, 0, 0, 0, time.UTC),
+			"20180701T0900Z/20180714":                       date(2018, time.July, 14),
+		}
+		for str, end := range complete {
+			str, end := str, end
+			It("should parse the complete end in "+str+" even though it is less precise", func() {
+				i, err := ParseInterval(str)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(i.End.Time).To(BeTemporally("==", end))
+			})
+		}
+
+		var problems = map[string]string{
+			"2018-07-01":             "Should have exactly one '/'",
+			"P1D/P2D":                "Has two durations",
+			"2018-07-14/2018-07-01":  "Ends before it starts",
+			"2018-07-01/07-14T17:00": "Abbreviated end is longer than the start",
+		}
+		for str, problem := range problems {
+			str, problem := str, problem
+			It("should reject "+str, func() {
+				_, err := ParseInterval(str)
+				Expect(err).To(BeAssignableToTypeOf(&ParseError{}))
+				Expect(err.(*ParseError).Problem).To(Equal(problem))
+			})
+		}
+	})
+
+	Describe("set operations", func() {
+		var a, b, c Interval
+
+		BeforeEach(func() {
+			a = NewInterval(date(2018, time.July, 1), date(2018, time.July, 10))
+			b = NewInterval(date(2018, time.July, 5), date(2018, time.July, 15))
+			c = NewInterval(date(2018, time.July, 15), date(2018, time.July, 20))
+		})
+
+		It("should include the start but not the end", func() {
+			Expect(a.Contains(date(2018, time.July, 1))).To(BeTrue())
+			Expect(a.Contains(date(2018, time.July, 10))).To(BeFalse())
+		})
+
+		It("should find overlaps", func() {
+			Expect(a.Overlaps(b)).To(BeTrue())
+			Expect(b.Overlaps(c)).To(BeFalse())
+		})
+
+		It("should intersect overlapping intervals", func() {
+			res, ok := a.Intersect(b)
+			Expect(ok).To(BeTrue())
+			Expect(res).To(Equal(NewInterval(date(2018, time.July, 5), date(2018, time.July, 10))))
+
+			_, ok = a.Intersect(c)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should union touching intervals", func() {
+			res, ok := b.Union(c)
+			Expect(ok).To(BeTrue())
+			Expect(res).To(Equal(NewInterval(date(2018, time.July, 5), date(2018, time.July, 20))))
+
+			_, ok = a.Union(c)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	It("should round trip JSON", func() {
+		var v struct{ Window Interval }
+		in := `{"Window":"2018-07-01T09:00Z/17:00"}`
+		Expect(json.Unmarshal([]byte(in), &v)).To(Succeed())
+		Expect(v.Window.Duration()).To(Equal(8 * time.Hour))
+		Expect(json.Marshal(v)).To(Equal([]byte(in)))
+	})
+})
@@ -0,0 +1,69 @@
+package gotime
+
+import (
+	"database/sql/driver"
+)
+
+// NullTime represents a Time that may be null, such as a nullable database
+// column or an optional JSON field. It is analogous to sql.NullTime.
+// NullTime implements the sql.Scanner interface so it can be used as a
+// scan destination, and an invalid NullTime is marshaled as NULL, null, or
+// an empty string as appropriate.
+type NullTime struct {
+	Time  Time
+	Valid bool // Valid is true if Time is not NULL
+}
+
+// Scan implements the sql.Scanner interface. NULL results in an invalid NullTime,
+// and anything else is handed to Time.Scan. If that fails, the NullTime is invalid.
+func (n *NullTime) Scan(value interface{}) error {
+	if value == nil {
+		n.Time, n.Valid = Time{}, false
+		return nil
+	}
+	var t Time
+	err := t.Scan(value)
+	n.Time, n.Valid = t, err == nil
+	return err
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Time.Value()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both null and an empty string result in an invalid NullTime.
+func (n *NullTime) UnmarshalJSON(data []byte) error {
+	err := n.Time.UnmarshalJSON(data)
+	n.Valid = err == nil && string(data) != "null" && string(data) != `""`
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface. An invalid NullTime is marshaled as null.
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Time.MarshalJSON()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// An empty string results in an invalid NullTime.
+func (n *NullTime) UnmarshalText(data []byte) error {
+	err := n.Time.UnmarshalText(data)
+	n.Valid = err == nil && len(data) != 0
+	return err
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid NullTime is marshaled as an empty string.
+func (n NullTime) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Time.MarshalText()
+}
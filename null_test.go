@@ -0,0 +1,89 @@
+package gotime_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime"
+)
+
+var _ = Describe("NullTime", func() {
+	var ref time.Time
+
+	BeforeEach(func() {
+		ref = time.Date(2018, time.July, 14, 10, 5, 0, 0, time.UTC)
+	})
+
+	Describe("SQL", func() {
+		It("should scan NULL", func() {
+			n := NullTime{Time: Time{Time: ref}, Valid: true}
+			Expect(n.Scan(nil)).To(Succeed())
+			Expect(n.Valid).To(BeFalse())
+			Expect(n.Time.IsZero()).To(BeTrue())
+			Expect(n.Value()).To(BeNil())
+		})
+
+		It("should scan a time", func() {
+			var n NullTime
+			Expect(n.Scan(ref)).To(Succeed())
+			Expect(n.Valid).To(BeTrue())
+			Expect(n.Value()).To(Equal(ref))
+		})
+
+		It("should be invalid if the time cannot be scanned", func() {
+			n := NullTime{Time: Time{Time: ref}, Valid: true}
+			Expect(n.Scan("garbage")).NotTo(Succeed())
+			Expect(n.Valid).To(BeFalse())
+			Expect(n.Value()).To(BeNil())
+		})
+	})
+
+	Describe("JSON", func() {
+		type row struct {
+			Born NullTime
+		}
+
+		for _, in := range []string{`{"Born":null}`, `{"Born":""}`} {
+			in := in
+			It("should unmarshal "+in+" as invalid", func() {
+				v := row{NullTime{Time: Time{Time: ref}, Valid: true}}
+				Expect(json.Unmarshal([]byte(in), &v)).To(Succeed())
+				Expect(v.Born.Valid).To(BeFalse())
+				Expect(json.Marshal(v)).To(Equal([]byte(`{"Born":null}`)))
+			})
+		}
+
+		It("should round trip a valid time", func() {
+			var v row
+			in := `{"Born":"2018-07-14"}`
+			Expect(json.Unmarshal([]byte(in), &v)).To(Succeed())
+			Expect(v.Born.Valid).To(BeTrue())
+			Expect(json.Marshal(v)).To(Equal([]byte(in)))
+		})
+
+		It("should be invalid after an error", func() {
+			var v row
+			Expect(json.Unmarshal([]byte(`{"Born":"yesterday"}`), &v)).NotTo(Succeed())
+			Expect(v.Born.Valid).To(BeFalse())
+		})
+	})
+
+	Describe("Text", func() {
+		It("should treat an empty string as invalid", func() {
+			n := NullTime{Valid: true}
+			Expect(n.UnmarshalText(nil)).To(Succeed())
+			Expect(n.Valid).To(BeFalse())
+			Expect(n.MarshalText()).To(BeEmpty())
+		})
+
+		It("should round trip a valid time", func() {
+			var n NullTime
+			Expect(n.UnmarshalText([]byte("2018-W28-6"))).To(Succeed())
+			Expect(n.Valid).To(BeTrue())
+			Expect(n.MarshalText()).To(Equal([]byte("2018-W28-6")))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package gotime
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -42,16 +43,130 @@ func (p *ParseError) Error() string {
 // It uses the package specified pattern finding functions
 // to determine the pattern and then calls time.Parse internally.
 func Parse(str string) (time.Time, error) {
+	t, _, err := ParseWithFormat(str)
+	return t, err
+}
+
+// ParseWithFormat is like Parse, but it also returns the format that
+// was detected. Passing that format to Format will reproduce str.
+func ParseWithFormat(str string) (time.Time, string, error) {
 	datefmt, err := DateParser(str)
 	if err != nil {
-		return time.Time{}, errors.Wrap(err, NewParseError(str, "Parsing date format"))
+		return time.Time{}, "", errors.Wrap(err, NewParseError(str, "Parsing date format"))
 	}
 	timefmt, err := TimeParser(str)
 	if err != nil {
-		return time.Time{}, errors.Wrap(err, NewParseError(str, "Parsing time format"))
+		return time.Time{}, "", errors.Wrap(err, NewParseError(str, "Parsing time format"))
+	}
+	format := datefmt + timefmt
+
+	if isWeekFormat(datefmt) {
+		if str, datefmt, err = fromWeekDate(str, datefmt); err != nil {
+			return time.Time{}, "", err
+		}
+	}
+
+	t, err := parse(datefmt+timefmt, str)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	return t, format, nil
+}
+
+// Format is like time.Time.Format, but it also understands the week date
+// formats. It is the counterpart to ParseWithFormat.
+func Format(t time.Time, layout string) string {
+	// The formats with days need to come first, as they are prefixed by those without
+	for _, week := range []string{WeekDayFormat, WeekDayFormatBasic, WeekFormat, WeekFormatBasic} {
+		if strings.HasPrefix(layout, week) {
+			y, w := t.ISOWeek()
+			date := strings.NewReplacer(
+				"2006", fmt.Sprintf("%04d", y),
+				"ww", fmt.Sprintf("%02d", w),
+				"D", strconv.Itoa((int(t.Weekday())+6)%7+1),
+			).Replace(week)
+			return date + t.Format(layout[len(week):])
+		}
 	}
+	return t.Format(layout)
+}
 
-	return time.Parse(datefmt+timefmt, str)
+// ISO-8601 week date formats. A week date is the ISO week-numbering year,
+// the week of that year, and optionally the day of the week from 1 (Monday)
+// to 7 (Sunday). time.Parse has no notion of week numbers, so these formats
+// are placeholders that Parse recognizes and converts to calendar dates
+// before calling time.Parse. Note that the year of a week date can differ
+// from the calendar year: 2009-W01-1 is 2008-12-29.
+const (
+	WeekFormat         = "2006-Www"
+	WeekFormatBasic    = "2006Www"
+	WeekDayFormat      = "2006-Www-D"
+	WeekDayFormatBasic = "2006WwwD"
+)
+
+// isWeekFormat determines whether a format is one of the week date formats.
+func isWeekFormat(datefmt string) bool {
+	switch datefmt {
+	case WeekFormat, WeekFormatBasic, WeekDayFormat, WeekDayFormatBasic:
+		return true
+	}
+	return false
+}
+
+// fromWeekDate replaces the week date at the start of str with its calendar
+// date, returning the new string and the format of its date portion.
+// If the day of the week is omitted, Monday is assumed.
+func fromWeekDate(str, datefmt string) (string, string, error) {
+	if len(str) < len(datefmt) {
+		return "", "", NewParseError(str, "Too short for "+datefmt)
+	}
+	date := strings.Replace(str[:len(datefmt)], "-", "", -1)
+
+	year, err := strconv.Atoi(date[:4])
+	if err != nil {
+		return "", "", NewParseError(str, "Invalid year")
+	}
+	week, err := strconv.Atoi(date[5:7])
+	if err != nil || date[4] != 'W' {
+		return "", "", NewParseError(str, "Invalid week")
+	}
+	day := 1
+	if len(date) == 8 {
+		if day, err = strconv.Atoi(date[7:]); err != nil {
+			return "", "", NewParseError(str, "Invalid weekday")
+		}
+	}
+
+	t := ISOWeekDate(year, week, time.Weekday(day%7))
+	return t.Format("20060102") + str[len(datefmt):], "20060102", nil
+}
+
+// parse calls time.Parse after making room for the parts of ISO-8601
+// that time.Time cannot represent. Hour 24 (the end of the day) is parsed
+// as 23 and moved forward an hour, and a leap second (second 60) is parsed
+// as 59 and moved forward a second. The layout must have a fixed width up to
+// and including the seconds, which is true of every layout this package uses.
+func parse(layout, str string) (time.Time, error) {
+	var carry time.Duration
+	for _, roll := range []struct {
+		layout, from, to string
+		by               time.Duration
+	}{
+		{"15", "24", "23", time.Hour},
+		{"05", "60", "59", time.Second},
+	} {
+		if idx := strings.Index(layout, roll.layout); idx != -1 && idx+2 <= len(str) &&
+			str[idx:idx+2] == roll.from {
+			str = str[:idx] + roll.to + str[idx+2:]
+			carry += roll.by
+		}
+	}
+
+	t, err := time.Parse(layout, str)
+	if err != nil {
+		return t, err
+	}
+	return t.Add(carry), nil
 }
 
 // GetDateFormatFast attempts to find the date format. Fast.
@@ -61,8 +176,8 @@ func Parse(str string) (time.Time, error) {
 // return "2006-01-02", and input of "10000000" would return "20060102".
 // Do not use this function if you are unsure that your string contains
 // a valid date.
-// Valid options: --MM-DD, --MMDD, YYYY-MM-DD, YYYY-MM, YYYY, YYYYMMDD
-// Currently invalid: YYYY-Www, YYYY-DDD, YYYYDDD
+// Valid options: --MM-DD, --MMDD, YYYY-MM-DD, YYYY-MM, YYYY, YYYYMMDD,
+// YYYY-Www, YYYYWww, YYYY-Www-D, YYYYWwwD (see WeekFormat), YYYY-DDD, YYYYDDD
 func GetDateFormatFast(str string) (string, error) {
 	str = strings.Split(str, "T")[0]
 	if strings.Index(str, "--") == 0 { // no year, needs to come first
@@ -74,26 +189,27 @@ func GetDateFormatFast(str string) (string, error) {
 			return "--01-02", nil
 		}
 	} else if strings.Contains(str, "W") { // Year and week
-		// TODO: How to use this format with time.Parse()?
 		// Can be YYYY-Www, YYYYWww, YYYY-Www-D, YYYYWwwD
-		// switch strings.Count(str, "-") {
-		// case 2:
-		// 	datefmt = "2006-W01-D"
-		// case 1:
-		// 	datefmt = "2006-W01"
-		// }
-		return "", NewParseError(str, "Cannot parse week numbers")
+		switch strings.Count(str, "-") {
+		case 2:
+			return WeekDayFormat, nil
+		case 1:
+			return WeekFormat, nil
+		case 0:
+			if len(str) == 8 {
+				return WeekDayFormatBasic, nil
+			}
+			return WeekFormatBasic, nil
+		}
 	} else if s, _ := strconv.Atoi(str); strconv.Itoa(s) == str { // nolint: gosec, errcheck
 		switch len(str) {
 		case 7: // YYYYDDD
-			return "", NewParseError(str, "Cannot parse day of year")
+			return "2006002", nil
 		case 8: // YYYYMMDD
 			return "20060102", nil
 		}
 	} else if len(str) == 8 { // YYYY-DDD
-		// TODO: How to use this format with time.Parse()?
-		// return "2006-002"?
-		return "", NewParseError(str, "Cannot parse day of year")
+		return "2006-002", nil
 	} else if strings.Contains(str, "-") { // Most likely formats
 		// YYYY-MM, YYYY-MM-DD
 		switch strings.Count(str, "-") {
@@ -127,10 +243,11 @@ func GetTimeFormatFast(str string) (string, error) {
 		return "", nil
 	}
 
-	// Strip off the timezone and nanosecond portions first, if they exist
+	// Strip off the timezone and nanosecond portions first, if they exist.
+	// The hour always comes first, so the TZ can start as early as index 2.
 	var tz string
 	for _, ch := range []string{"Z", "+", "-"} {
-		if idx := strings.LastIndex(str, ch); idx > 5 {
+		if idx := strings.LastIndex(str, ch); idx >= 2 {
 			str, tz = str[:idx], str[idx:]
 			break
 		}
@@ -138,7 +255,7 @@ func GetTimeFormatFast(str string) (string, error) {
 
 	var ns string
 	if idx := strings.Index(str, "."); idx != -1 {
-		str, ns = str[:idx-1], str[idx-1:]
+		str, ns = str[:idx], str[idx:]
 	}
 
 	// Handle the HMS portion
@@ -167,7 +284,11 @@ func GetTimeFormatFast(str string) (string, error) {
 
 	// Handle the TZ portion
 	if len(tz) > 0 {
-		timefmt.WriteString(string(tz[0])) // nolint: gosec, errcheck
+		if tz[0] == 'Z' {
+			timefmt.WriteString("Z") // nolint: gosec, errcheck
+		} else { // The layout uses - for both + and -
+			timefmt.WriteString("-") // nolint: gosec, errcheck
+		}
 		tz = tz[1:]
 		switch len(tz) {
 		case 2:
@@ -182,18 +303,304 @@ func GetTimeFormatFast(str string) (string, error) {
 	return timefmt.String(), nil
 }
 
-// GetDateFormatSafely uses regexes to parse date formats. *NOT IMPLEMENTED
+// GetDateFormatSafely uses regexes to parse date formats.
 // Using this function ensures the returned format string will
 // work correctly. Use this function when you are unsure if
-// your date is properly formatted.
+// your date is properly formatted. Each component is checked
+// for width and range (eg the day against the length of the month,
+// including leap years), and the Problem of the returned ParseError
+// names the component at fault.
+// Valid options: --MM-DD, --MMDD, YYYY-MM-DD, YYYY-MM, YYYY, YYYYMMDD,
+// YYYY-Www, YYYYWww, YYYY-Www-D, YYYYWwwD (see WeekFormat), YYYY-DDD, YYYYDDD
 func GetDateFormatSafely(str string) (string, error) {
-	return "", errors.NotImplementedf("This function has not been implemented")
+	str = strings.Split(str, "T")[0]
+
+	if m := safeWeek.FindStringSubmatch(str); m != nil {
+		return checkWeek(str, m[1], m[2], m[3], m[4])
+	}
+
+	if m := safeNoYear.FindStringSubmatch(str); m != nil {
+		if m[2] == "" { // --MMDD
+			if err := checkWidth(str, "month and day", m[1], 4); err != nil {
+				return "", err
+			}
+			return safely("--0102", checkDate(str, "", m[1][:2], m[1][2:]))
+		}
+		return safely("--01-02", checkDate(str, "", m[1], m[2]))
+	}
+
+	if m := safeExtended.FindStringSubmatch(str); m != nil {
+		if m[3] == "" && len(m[2]) == 3 { // YYYY-DDD
+			return safely("2006-002", checkOrdinal(str, m[1], m[2]))
+		}
+		if m[3] == "" { // YYYY-MM
+			return safely("2006-01", checkDate(str, m[1], m[2], ""))
+		}
+		return safely("2006-01-02", checkDate(str, m[1], m[2], m[3]))
+	}
+
+	if safeBasic.MatchString(str) {
+		switch len(str) {
+		case 4: // YYYY
+			return safely("2006", checkDate(str, str, "", ""))
+		case 7: // YYYYDDD
+			return safely("2006002", checkOrdinal(str, str[:4], str[4:]))
+		case 8: // YYYYMMDD
+			return safely("20060102", checkDate(str, str[:4], str[4:6], str[6:]))
+		}
+		return "", NewParseError(str,
+			fmt.Sprintf("%d digits is neither YYYY, YYYYDDD, nor YYYYMMDD", len(str)))
+	}
+
+	return "", NewParseError(str, "Not an ISO-8601 date")
 }
 
-// GetTimeFormatSafely uses regexes to parse date formats. *NOT IMPLEMENTED
+// Patterns used by GetDateFormatSafely. They are deliberately loose
+// about the number of digits so that checkDate can say which
+// component is wrong rather than simply failing to match.
+var (
+	safeNoYear   = regexp.MustCompile(`^--(\d+)(?:-(\d+))?$`)
+	safeExtended = regexp.MustCompile(`^(\d+)-(\d+)(?:-(\d+))?$`)
+	safeBasic    = regexp.MustCompile(`^\d+$`)
+	safeWeek     = regexp.MustCompile(`^(\d+)(-?)W(\d+)(?:-(\d+))?$`)
+)
+
+// safely returns the format only if the validation that produced err passed.
+func safely(format string, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	return format, nil
+}
+
+// checkDate validates the year, month, and day of a date string.
+// Empty components are not checked. If the year is empty (eg --MM-DD)
+// February is allowed to have 29 days.
+func checkDate(str, year, month, day string) error {
+	y := 2000 // Any leap year will do
+	if year != "" {
+		if err := checkWidth(str, "year", year, 4); err != nil {
+			return err
+		}
+		y, _ = strconv.Atoi(year) // nolint: gosec, errcheck
+	}
+
+	if month == "" {
+		return nil
+	}
+	m, err := checkRange(str, "month", month, 1, 12)
+	if err != nil || day == "" {
+		return err
+	}
+
+	last := time.Date(y, time.Month(m)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	_, err = checkRange(str, "day", day, 1, last)
+	return err
+}
+
+// checkOrdinal validates the year and day of the year of an ordinal date.
+func checkOrdinal(str, year, day string) error {
+	if err := checkWidth(str, "year", year, 4); err != nil {
+		return err
+	}
+	y, _ := strconv.Atoi(year) // nolint: gosec, errcheck
+	last := time.Date(y, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	_, err := checkNumber(str, "day of year", day, 3, 1, last)
+	return err
+}
+
+// checkWeek validates the components of a week date and returns its format.
+// The hyphens must either both be present or both be absent, and the week
+// must exist in the given year: only some years have a 53rd week.
+func checkWeek(str, year, dash, week, day string) (string, error) {
+	if len(week) == 3 && day == "" { // YYYYWwwD
+		week, day = week[:2], week[2:]
+		if dash != "" {
+			return "", NewParseError(str, "Mixes basic and extended formats")
+		}
+	} else if day != "" && dash == "" {
+		return "", NewParseError(str, "Mixes basic and extended formats")
+	}
+	if err := checkWidth(str, "year", year, 4); err != nil {
+		return "", err
+	}
+	y, _ := strconv.Atoi(year) // nolint: gosec, errcheck
+	_, last := time.Date(y, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	if _, err := checkRange(str, "week", week, 1, last); err != nil {
+		return "", err
+	}
+
+	format := WeekFormat
+	if day != "" {
+		if _, err := checkNumber(str, "weekday", day, 1, 1, 7); err != nil {
+			return "", err
+		}
+		format = WeekDayFormat
+	}
+	if dash == "" {
+		format = strings.Replace(format, "-", "", -1)
+	}
+	return format, nil
+}
+
+// checkWidth ensures a numeric component has exactly <width> digits.
+func checkWidth(str, name, value string, width int) error {
+	if len(value) != width {
+		digits := "digits"
+		if width == 1 {
+			digits = "digit"
+		}
+		return NewParseError(str, fmt.Sprintf("%s '%s' should have %d %s", name, value, width, digits))
+	}
+	return nil
+}
+
+// checkRange ensures a two-digit component is between min and max, inclusive,
+// and returns its integer value.
+func checkRange(str, name, value string, min, max int) (int, error) {
+	return checkNumber(str, name, value, 2, min, max)
+}
+
+// checkNumber ensures a component of <width> digits is between min and max,
+// inclusive, and returns its integer value.
+func checkNumber(str, name, value string, width, min, max int) (int, error) {
+	if err := checkWidth(str, name, value, width); err != nil {
+		return 0, err
+	}
+	n, _ := strconv.Atoi(value) // nolint: gosec, errcheck
+	if n < min || n > max {
+		return 0, NewParseError(str,
+			fmt.Sprintf("%s %s is out of range [%0*d-%0*d]", name, value, width, min, width, max))
+	}
+	return n, nil
+}
+
+// GetTimeFormatSafely uses regexes to parse time formats.
 // Using this function ensures the returned format string will
 // work correctly. Use this function when you are unsure if
-// your time is properly formatted.
+// your time is properly formatted. Hours, minutes, and seconds
+// are range checked, allowing for 24:00:00 (the end of the day)
+// and a leap second at :59:60, both of which Parse will roll
+// over to the next day or minute as time.Time cannot hold them.
+// Fractional seconds may have any number of digits, and offsets
+// must be Z, +/-hh, +/-hhmm, or +/-hh:mm.
 func GetTimeFormatSafely(str string) (string, error) {
-	return "", errors.NotImplementedf("This function has not been implemented")
+	timefmt := strings.Builder{}
+
+	// Leave off the date portion
+	if idx := strings.Index(str, "T"); idx != -1 {
+		str = str[idx+1:]
+		timefmt.WriteString("T") // nolint: gosec, errcheck
+	} else {
+		return "", nil
+	}
+
+	m := safeTime.FindStringSubmatch(str)
+	if m == nil {
+		return "", NewParseError(str, "Not an ISO-8601 time")
+	}
+	hms, sep, ns, tz := m[1], m[2], m[3], m[4]
+
+	// Handle the HMS portion
+	parts, colon := strings.Split(hms, ":"), ":"
+	if len(parts) == 1 {
+		if len(hms)%2 != 0 {
+			return "", NewParseError(str, fmt.Sprintf("'%s' should have 2, 4, or 6 digits", hms))
+		}
+		parts, colon = nil, ""
+		for i := 0; i < len(hms); i += 2 {
+			parts = append(parts, hms[i:i+2])
+		}
+	}
+	if len(parts) > 3 {
+		return "", NewParseError(str, fmt.Sprintf("'%s' has too many components", hms))
+	}
+	if err := checkClock(str, parts, ns); err != nil {
+		return "", err
+	}
+	timefmt.WriteString("15") // nolint: gosec, errcheck
+	for i, layout := range []string{"04", "05"}[:len(parts)-1] {
+		if i > 0 || colon != "" {
+			timefmt.WriteString(colon) // nolint: gosec, errcheck
+		}
+		timefmt.WriteString(layout) // nolint: gosec, errcheck
+	}
+
+	// Handle the NS portion
+	if sep != "" {
+		if len(parts) != 3 {
+			return "", NewParseError(str, "Only seconds may have a fraction")
+		}
+		if ns == "" {
+			return "", NewParseError(str, fmt.Sprintf("'%s' is not followed by digits", sep))
+		}
+		timefmt.WriteString(sep + strings.Repeat("0", len(ns))) // nolint: gosec, errcheck
+	}
+
+	// Handle the TZ portion
+	layout, err := checkOffset(str, tz)
+	if err != nil {
+		return "", err
+	}
+	timefmt.WriteString(layout) // nolint: gosec, errcheck
+
+	return timefmt.String(), nil
+}
+
+// safeTime is the pattern used by GetTimeFormatSafely. It splits a time into
+// its HMS, fraction separator, fraction, and offset, leaving the details to
+// checkClock and checkOffset.
+var safeTime = regexp.MustCompile(`^([\d:]+)(?:([.,])(\d*))?(Z|[+-][\d:]*)?$`)
+
+// checkClock validates the hour, minute, and second of a time string.
+// Hour 24 is only allowed as 24:00:00, and second 60 only at minute 59.
+func checkClock(str string, parts []string, ns string) error {
+	var hms [3]int
+	for i, name := range []string{"hour", "minute", "second"}[:len(parts)] {
+		var err error
+		if hms[i], err = checkRange(str, name, parts[i], 0, []int{24, 59, 60}[i]); err != nil {
+			return err
+		}
+	}
+
+	if hms[0] == 24 && (hms[1] != 0 || hms[2] != 0 || strings.Trim(ns, "0") != "") {
+		return NewParseError(str, "hour 24 is only valid as 24:00:00")
+	}
+	if hms[2] == 60 && hms[1] != 59 {
+		return NewParseError(str, "second 60 is only valid as a leap second after minute 59")
+	}
+	return nil
+}
+
+// checkOffset validates a time zone offset and returns its format.
+func checkOffset(str, tz string) (string, error) {
+	switch {
+	case tz == "":
+		return "", nil
+	case tz == "Z":
+		return "Z07:00", nil
+	}
+
+	var layout string
+	hh, mm := tz[1:], ""
+	switch {
+	case len(hh) == 2:
+		layout = "-07"
+	case len(hh) == 4:
+		hh, mm, layout = hh[:2], hh[2:], "-0700"
+	case len(hh) == 5 && hh[2] == ':':
+		hh, mm, layout = hh[:2], hh[3:], "-07:00"
+	default:
+		return "", NewParseError(str, fmt.Sprintf("offset '%s' should be ±hh, ±hhmm, or ±hh:mm", tz))
+	}
+
+	if _, err := checkRange(str, "offset hour", hh, 0, 14); err != nil {
+		return "", err
+	}
+	if mm != "" {
+		if _, err := checkRange(str, "offset minute", mm, 0, 59); err != nil {
+			return "", err
+		}
+	}
+	return layout, nil
 }
@@ -26,6 +26,27 @@ var _ = Describe("Parse", func() {
 			Expect(now.Format("20060102030405")).To(Equal(res.Format("20060102030405")))
 		})
 
+		It("should parse week dates", func() {
+			exp := time.Date(2008, time.December, 29, 0, 0, 0, 0, time.UTC)
+			Expect(Parse("2009-W01")).To(Equal(exp))
+			Expect(Parse("2009W01")).To(Equal(exp))
+			Expect(Parse("2009-W01-1")).To(Equal(exp))
+			Expect(Parse("2009W011")).To(Equal(exp))
+			Expect(Parse("2009-W53-7T10:30:00Z")).To(Equal(time.Date(2010, time.January, 3, 10, 30, 0, 0, time.UTC)))
+			Expect(Parse("2018W287T153000-0500")).To(BeTemporally("==",
+				time.Date(2018, time.July, 15, 20, 30, 0, 0, time.UTC)))
+		})
+
+		It("should parse ordinal dates", func() {
+			exp := time.Date(2016, time.December, 31, 0, 0, 0, 0, time.UTC)
+			Expect(Parse("2016-366")).To(Equal(exp))
+			Expect(Parse("2016366")).To(Equal(exp))
+			Expect(Parse("2018-195T10:05:00Z")).To(Equal(time.Date(2018, time.July, 14, 10, 5, 0, 0, time.UTC)))
+
+			_, err := Parse("2017-366")
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should return an error if DateParser does", func() {
 			// Note that GetTimeFormatFast does not return any errors
 			res, err := Parse("----T03:04:05")
@@ -51,32 +72,99 @@ var _ = Describe("Parse", func() {
 			})
 		}
 
+		var weeks = map[string]string{"2006-W01": WeekFormat, "2006W01": WeekFormatBasic,
+			"2006-W01-2": WeekDayFormat, "2006W012": WeekDayFormatBasic}
+		for d, format := range weeks {
+			d, format := d, format
+			It(fmt.Sprintf("It should parse week date %s", d), func() {
+				res, err := GetDateFormatFast(d + "T15:04")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(format))
+			})
+		}
+
 		// Exceptions to the rule
-		It("should choke on week numbers", func() {
-			res, err := GetDateFormatFast("2006-W01")
-			Expect(res).To(BeZero())
-			Expect(err).To(BeAssignableToTypeOf(&ParseError{}))
-			Expect(err.Error()).To(ContainSubstring("Cannot parse week numbers"))
-		})
-		It("should choke on day numbers with hyphens", func() {
+		It("should parse day numbers with hyphens", func() {
 			res, err := GetDateFormatFast("2006-002")
-			Expect(res).To(BeZero())
-			Expect(err).To(BeAssignableToTypeOf(&ParseError{}))
-			Expect(err.Error()).To(ContainSubstring("Cannot parse day of year"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal("2006-002"))
 		})
-		It("should choke on day numbers without hyphens", func() {
-			res, err := GetDateFormatFast("2006002")
-			Expect(res).To(BeZero())
-			Expect(err).To(BeAssignableToTypeOf(&ParseError{}))
-			Expect(err.Error()).To(ContainSubstring("Cannot parse day of year"))
+		It("should parse day numbers without hyphens", func() {
+			res, err := GetDateFormatFast("2006002T15:04:05")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal("2006002"))
+		})
+	})
+
+	Describe("GetDateFormatSafely", func() {
+		var dates = []string{"2006-01-02", "2006-01", "2006", "20060102", "--0102", "--01-02",
+			"2006-002", "2006002"}
+		for _, d := range dates {
+			It(fmt.Sprintf("It should parse date %s", d), func() {
+				res, err := GetDateFormatSafely(d)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(d))
+			})
+		}
+		for _, d := range timestamps { // nolint: dupl
+			It(fmt.Sprintf("It should parse timestamp %s", d), func() {
+				res, err := GetDateFormatSafely(d)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(strings.Split(d, "T")[0]))
+			})
+		}
+
+		var weeks = map[string]string{"2009-W53": WeekFormat, "2009W53": WeekFormatBasic,
+			"2009-W53-7": WeekDayFormat, "2009W537": WeekDayFormatBasic}
+		for d, format := range weeks {
+			d, format := d, format
+			It(fmt.Sprintf("It should parse week date %s", d), func() {
+				res, err := GetDateFormatSafely(d + "T15:04")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(format))
+			})
+		}
+
+		It("should allow leap days", func() {
+			Expect(GetDateFormatSafely("2016-02-29")).To(Equal("2006-01-02"))
+			Expect(GetDateFormatSafely("--0229")).To(Equal("--0102"))
 		})
+
+		var problems = map[string]string{
+			"200-100-50":  "year '200' should have 4 digits",
+			"2006-100-50": "month '100' should have 2 digits",
+			"2006-13-01":  "month 13 is out of range [01-12]",
+			"20060100":    "day 00 is out of range [01-31]",
+			"2017-02-29":  "day 29 is out of range [01-28]",
+			"--04-31":     "day 31 is out of range [01-30]",
+			"--123":       "month and day '123' should have 4 digits",
+			"200601":      "6 digits is neither YYYY, YYYYDDD, nor YYYYMMDD",
+			"2017-366":    "day of year 366 is out of range [001-365]",
+			"2016000":     "day of year 000 is out of range [001-366]",
+			"2006/01/02":  "Not an ISO-8601 date",
+			"2018-W53":    "week 53 is out of range [01-52]",
+			"2018-W00-1":  "week 00 is out of range [01-52]",
+			"2018-W1":     "week '1' should have 2 digits",
+			"2018-W01-8":  "weekday 8 is out of range [1-7]",
+			"2018-W011":   "Mixes basic and extended formats",
+			"18-W01":      "year '18' should have 4 digits",
+		}
+		for d, problem := range problems {
+			d, problem := d, problem
+			It(fmt.Sprintf("should reject %s", d), func() {
+				res, err := GetDateFormatSafely(d)
+				Expect(res).To(BeZero())
+				Expect(err).To(BeAssignableToTypeOf(&ParseError{}))
+				Expect(err.(*ParseError).Problem).To(Equal(problem))
+			})
+		}
 	})
 
 	Describe("GetTimeFormatFast", func() {
 		// Pretty sure that "Z07:00" is far from valid, but according
 		// to Google it is, like, some sort of standard.
 		var times = []string{"T15:04:05", "T150405", "T1504", "T15:04",
-			"T15", "T150405Z", "T150405-07:00", "T150405Z07:00"}
+			"T15", "T150405Z", "T150405-07:00", "T150405Z07:00", "T15:04:05.000", "T15:04Z", "T15-07"}
 		for _, d := range times {
 			It(fmt.Sprintf("It should parse time %s", d), func() {
 				res, err := GetTimeFormatFast(d)
@@ -85,6 +173,12 @@ var _ = Describe("Parse", func() {
 			})
 		}
 
+		It("should parse positive offsets", func() {
+			res, err := GetTimeFormatFast("T15:04:05.123456+05:30")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal("T15:04:05.000000-07:00"))
+		})
+
 		for _, d := range timestamps { // nolint: dupl
 			It(fmt.Sprintf("It should parse timestamp %s", d), func() {
 				res, err := GetDateFormatFast(d)
@@ -93,4 +187,65 @@ var _ = Describe("Parse", func() {
 			})
 		}
 	})
+
+	Describe("GetTimeFormatSafely", func() {
+		var times = []string{"T15:04:05", "T150405", "T1504", "T15:04",
+			"T15", "T150405Z07:00", "T150405-07:00", "T15:04:05-0700", "T15:04-07",
+			"T15:04:05.000", "T150405,000000000Z07:00"}
+		for _, d := range times {
+			It(fmt.Sprintf("It should parse time %s", d), func() {
+				res, err := GetTimeFormatSafely(strings.Replace(d, "Z07:00", "Z", 1))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(d))
+			})
+		}
+
+		It("should return an empty format without a time", func() {
+			Expect(GetTimeFormatSafely("2006-01-02")).To(BeEmpty())
+		})
+
+		It("should be usable as the TimeParser", func() {
+			defer func(tp func(string) (string, error)) { TimeParser = tp }(TimeParser)
+			TimeParser = GetTimeFormatSafely
+
+			res, err := Parse("2018-07-14T24:00Z")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal(time.Date(2018, time.July, 15, 0, 0, 0, 0, time.UTC)))
+
+			res, err = Parse("2016-12-31T23:59:60Z")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal(time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)))
+
+			res, err = Parse("2018-07-14T10:30:00.123456+05:30")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.UTC()).To(Equal(time.Date(2018, time.July, 14, 5, 0, 0, 123456000, time.UTC)))
+		})
+
+		var problems = map[string]string{
+			"T99-":         "hour 99 is out of range [00-24]",
+			"T50:60:70":    "hour 50 is out of range [00-24]",
+			"T12:60":       "minute 60 is out of range [00-59]",
+			"T12:30:61":    "second 61 is out of range [00-60]",
+			"T24:00:01":    "hour 24 is only valid as 24:00:00",
+			"T12:30:60":    "second 60 is only valid as a leap second after minute 59",
+			"T123":         "'123' should have 2, 4, or 6 digits",
+			"T1:30":        "hour '1' should have 2 digits",
+			"T12:30:00:00": "'12:30:00:00' has too many components",
+			"T12:30.5":     "Only seconds may have a fraction",
+			"T12:30:00.":   "'.' is not followed by digits",
+			"T12:30+15":    "offset hour 15 is out of range [00-14]",
+			"T12:30+05:60": "offset minute 60 is out of range [00-59]",
+			"T12:30+5":     "offset '+5' should be ±hh, ±hhmm, or ±hh:mm",
+			"T12h30":       "Not an ISO-8601 time",
+		}
+		for d, problem := range problems {
+			d, problem := d, problem
+			It(fmt.Sprintf("should reject %s", d), func() {
+				res, err := GetTimeFormatSafely(d)
+				Expect(res).To(BeZero())
+				Expect(err).To(BeAssignableToTypeOf(&ParseError{}))
+				Expect(err.(*ParseError).Problem).To(Equal(problem))
+			})
+		}
+	})
 })
@@ -0,0 +1,245 @@
+package gotime
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Period represents an ISO-8601 duration such as P1Y2M10DT2H30M or P3W.
+// Unlike a time.Duration, the calendar components (years, months, weeks,
+// and days) are kept separate from the clock components (hours, minutes,
+// and seconds) because their lengths depend on when they are applied:
+// a month can have 28 to 31 days and a day can have 23 to 25 hours.
+// A Period is negative if its components are negative; ParsePeriod and
+// String use a leading minus sign (eg -P1D) for such periods, and a minus
+// sign on each negative component (eg P1Y-1M) for periods with mixed signs.
+type Period struct {
+	Years, Months, Weeks, Days           int
+	Hours, Minutes, Seconds, Nanoseconds int
+}
+
+// safePeriod matches an ISO-8601 duration. Only the seconds may have a fraction.
+var safePeriod = regexp.MustCompile(`^([-+])?P(?:(-?\d+)Y)?(?:(-?\d+)M)?(?:(-?\d+)W)?(?:(-?\d+)D)?` +
+	`(?:T(?:(-?\d+)H)?(?:(-?\d+)M)?(?:(-?\d+)(?:[.,](\d+))?S)?)?$`)
+
+// ParsePeriod parses an ISO-8601 duration, eg P1Y2M10DT2H30M, P3W, or PT0.5S.
+// A leading + or -, and a - on any component, are accepted as extensions
+// to the standard.
+func ParsePeriod(str string) (Period, error) {
+	m := safePeriod.FindStringSubmatch(str)
+	if m == nil {
+		return Period{}, NewParseError(str, "Not an ISO-8601 duration")
+	}
+	if strings.HasSuffix(str, "P") || strings.HasSuffix(str, "T") {
+		return Period{}, NewParseError(str, "Has no components")
+	}
+
+	var p Period
+	fields := []*int{&p.Years, &p.Months, &p.Weeks, &p.Days, &p.Hours, &p.Minutes, &p.Seconds}
+	for i, field := range fields {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return Period{}, NewParseError(str, fmt.Sprintf("'%s' is too large", m[i+2]))
+		}
+		*field = n
+	}
+	if ns := m[9]; ns != "" {
+		if len(ns) > 9 {
+			ns = ns[:9]
+		}
+		p.Nanoseconds, _ = strconv.Atoi(ns + strings.Repeat("0", 9-len(ns))) // nolint: gosec, errcheck
+		if strings.HasPrefix(m[8], "-") {
+			p.Nanoseconds = -p.Nanoseconds
+		}
+	}
+
+	if m[1] == "-" {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// String returns the period in ISO-8601 format. Zero components are omitted,
+// and the zero Period is PT0S. A period with mixed signs has a minus sign on
+// each negative component, eg P1Y-1M; ParsePeriod reads it back. Its seconds
+// and nanoseconds are written as one number, so they are normalized to the
+// same sign when read back.
+func (p Period) String() string {
+	b := strings.Builder{}
+	if p.isNegative() && !p.isMixed() {
+		b.WriteString("-") // nolint: gosec, errcheck
+		p = p.Negate()
+	}
+	b.WriteString("P") // nolint: gosec, errcheck
+	for _, c := range []struct {
+		n    int
+		unit string
+	}{{p.Years, "Y"}, {p.Months, "M"}, {p.Weeks, "W"}, {p.Days, "D"}} {
+		if c.n != 0 {
+			b.WriteString(strconv.Itoa(c.n) + c.unit) // nolint: gosec, errcheck
+		}
+	}
+
+	seconds, ns := p.seconds()
+	if p.Hours != 0 || p.Minutes != 0 || seconds != 0 || ns != 0 {
+		b.WriteString("T") // nolint: gosec, errcheck
+	}
+	for _, c := range []struct {
+		n    int
+		unit string
+	}{{p.Hours, "H"}, {p.Minutes, "M"}} {
+		if c.n != 0 {
+			b.WriteString(strconv.Itoa(c.n) + c.unit) // nolint: gosec, errcheck
+		}
+	}
+	if seconds != 0 || ns != 0 {
+		if seconds < 0 || ns < 0 {
+			b.WriteString("-") // nolint: gosec, errcheck
+			seconds, ns = -seconds, -ns
+		}
+		b.WriteString(strconv.FormatInt(seconds, 10)) // nolint: gosec, errcheck
+		if ns != 0 {
+			b.WriteString("." + strings.TrimRight(fmt.Sprintf("%09d", ns), "0")) // nolint: gosec, errcheck
+		}
+		b.WriteString("S") // nolint: gosec, errcheck
+	}
+
+	if b.Len() <= 2 { // Nothing but the sign and the P
+		return "PT0S"
+	}
+	return b.String()
+}
+
+// IsZero determines whether every component of the period is zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// isNegative determines whether any component of the period is negative.
+func (p Period) isNegative() bool {
+	for _, n := range []int{p.Years, p.Months, p.Weeks, p.Days,
+		p.Hours, p.Minutes, p.Seconds, p.Nanoseconds} {
+		if n < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isMixed determines whether the period has both positive and negative components.
+func (p Period) isMixed() bool {
+	return p.isNegative() && p.Negate().isNegative()
+}
+
+// Negate returns the period with every component negated.
+func (p Period) Negate() Period {
+	return Period{-p.Years, -p.Months, -p.Weeks, -p.Days,
+		-p.Hours, -p.Minutes, -p.Seconds, -p.Nanoseconds}
+}
+
+// Scale returns the period with every component multiplied by n.
+func (p Period) Scale(n int) Period {
+	return Period{n * p.Years, n * p.Months, n * p.Weeks, n * p.Days,
+		n * p.Hours, n * p.Minutes, n * p.Seconds, n * p.Nanoseconds}
+}
+
+// seconds returns the seconds and nanoseconds of the period as whole seconds
+// and a fraction of a second with the same sign.
+func (p Period) seconds() (int64, int64) {
+	const second = int64(time.Second)
+	sec := int64(p.Seconds) + int64(p.Nanoseconds)/second
+	ns := int64(p.Nanoseconds) % second
+	switch {
+	case sec > 0 && ns < 0:
+		sec, ns = sec-1, ns+second
+	case sec < 0 && ns > 0:
+		sec, ns = sec+1, ns-second
+	}
+	return sec, ns
+}
+
+// Clock returns the clock components of the period as a time.Duration.
+// A time.Duration is at most about 292 years, so Clock overflows for longer
+// periods; AddTo does not use it.
+func (p Period) Clock() time.Duration {
+	return time.Duration(p.Hours)*time.Hour + time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second + time.Duration(p.Nanoseconds)
+}
+
+// AddTo returns t plus the period. The years and months are added first,
+// clamping the day to the end of the resulting month (January 31st plus
+// P1M is February 28th or 29th). The weeks and days are added next and
+// keep the wall clock time across daylight savings time changes. Finally
+// the clock components are added as elapsed time.
+func (p Period) AddTo(t time.Time) time.Time {
+	t = AddMonths(t, 12*p.Years+p.Months)
+	t = t.AddDate(0, 0, 7*p.Weeks+p.Days)
+
+	// Add the clock components as whole seconds so that they cannot overflow a time.Duration
+	sec, ns := p.seconds()
+	sec += 3600*int64(p.Hours) + 60*int64(p.Minutes)
+	return time.Unix(t.Unix()+sec, int64(t.Nanosecond())+ns).In(t.Location())
+}
+
+// AddMonths returns t plus n months, clamping the day to the end of the
+// resulting month. Unlike time.Time.AddDate, adding one month to January 31st
+// results in the end of February rather than the beginning of March.
+func AddMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	last := time.Date(y, m+time.Month(n)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if d > last {
+		d = last
+	}
+	hh, mm, ss := t.Clock()
+	return time.Date(y, m+time.Month(n), d, hh, mm, ss, t.Nanosecond(), t.Location())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (p *Period) UnmarshalText(data []byte) error {
+	res, err := ParsePeriod(string(data))
+	if err != nil {
+		return err
+	}
+	*p = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p Period) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", p)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	return p.UnmarshalText([]byte(strings.Trim(string(data), "\"")))
+}
+
+// Value implements the driver.Valuer interface. The period is stored as an ISO-8601 string.
+func (p Period) Value() (driver.Value, error) {
+	return p.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts a string or byte slice.
+func (p *Period) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		return p.UnmarshalText([]byte(v))
+	case []byte:
+		return p.UnmarshalText(v)
+	}
+	return errors.Errorf("Error converting %T to period", value)
+}
@@ -0,0 +1,121 @@
+package gotime_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime"
+)
+
+var _ = Describe("Period", func() {
+	var periods = map[string]Period{
+		"P1Y2M10DT2H30M": {Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30},
+		"P3W":            {Weeks: 3},
+		"PT36H":          {Hours: 36},
+		"PT0.5S":         {Nanoseconds: 500000000},
+		"P1DT1.000001S":  {Days: 1, Seconds: 1, Nanoseconds: 1000},
+		"-P1M":           {Months: -1},
+		"PT0S":           {},
+		"P1Y-1M":         {Years: 1, Months: -1},
+		"P-1DT12H":       {Days: -1, Hours: 12},
+		"PT1H-60M":       {Hours: 1, Minutes: -60},
+		"P1DT-0.5S":      {Days: 1, Nanoseconds: -500000000},
+		"-PT1H1.5S":      {Hours: -1, Seconds: -1, Nanoseconds: -500000000},
+	}
+
+	for str, p := range periods {
+		str, p := str, p
+		It("should parse and format "+str, func() {
+			Expect(ParsePeriod(str)).To(Equal(p))
+			Expect(p.String()).To(Equal(str))
+		})
+	}
+
+	It("should write the seconds of a period with mixed signs as one number", func() {
+		p := Period{Minutes: 1, Seconds: 1, Nanoseconds: -250000000}
+		Expect(p.String()).To(Equal("PT1M0.75S"))
+		res, err := ParsePeriod(p.String())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Clock()).To(Equal(p.Clock()))
+		Expect(Period{Days: 1, Seconds: 1, Nanoseconds: -1000000000}.String()).To(Equal("P1D"))
+	})
+
+	It("should format seconds longer than a time.Duration", func() {
+		p := Period{Seconds: 400 * 365 * 86400, Nanoseconds: 500000000}
+		Expect(p.String()).To(Equal("PT12614400000.5S"))
+		Expect(ParsePeriod(p.String())).To(Equal(p))
+		Expect(p.Negate().String()).To(Equal("-PT12614400000.5S"))
+	})
+
+	It("should accept a comma as the decimal separator", func() {
+		Expect(ParsePeriod("PT1,25S")).To(Equal(Period{Seconds: 1, Nanoseconds: 250000000}))
+	})
+
+	var problems = map[string]string{
+		"1Y":                     "Not an ISO-8601 duration",
+		"P1H":                    "Not an ISO-8601 duration",
+		"P1M1Y":                  "Not an ISO-8601 duration",
+		"PT1.5M":                 "Not an ISO-8601 duration",
+		"P":                      "Has no components",
+		"P1DT":                   "Has no components",
+		"P99999999999999999999D": "'99999999999999999999' is too large",
+	}
+	for str, problem := range problems {
+		str, problem := str, problem
+		It("should reject "+str, func() {
+			_, err := ParsePeriod(str)
+			Expect(err).To(BeAssignableToTypeOf(&ParseError{}))
+			Expect(err.(*ParseError).Problem).To(Equal(problem))
+		})
+	}
+
+	Describe("AddTo", func() {
+		It("should clamp to the end of the month", func() {
+			t := time.Date(2018, time.January, 31, 10, 0, 0, 0, time.UTC)
+			Expect(Period{Months: 1}.AddTo(t)).To(Equal(time.Date(2018, time.February, 28, 10, 0, 0, 0, time.UTC)))
+			Expect(Period{Years: 2, Months: 1}.AddTo(t)).To(Equal(time.Date(2020, time.February, 29, 10, 0, 0, 0, time.UTC)))
+			Expect(Period{Months: -2}.AddTo(t)).To(Equal(time.Date(2017, time.November, 30, 10, 0, 0, 0, time.UTC)))
+		})
+
+		It("should keep the wall clock for days but not for hours", func() {
+			chicago, err := time.LoadLocation("America/Chicago")
+			Expect(err).NotTo(HaveOccurred())
+			t := time.Date(2018, time.March, 10, 12, 0, 0, 0, chicago)
+			Expect(Period{Days: 1}.AddTo(t)).To(Equal(time.Date(2018, time.March, 11, 12, 0, 0, 0, chicago)))
+			Expect(Period{Hours: 24}.AddTo(t)).To(Equal(time.Date(2018, time.March, 11, 13, 0, 0, 0, chicago)))
+		})
+
+		It("should add weeks", func() {
+			t := time.Date(2018, time.December, 20, 0, 0, 0, 0, time.UTC)
+			Expect(Period{Weeks: 3}.AddTo(t)).To(Equal(time.Date(2019, time.January, 10, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should add clock components longer than a time.Duration", func() {
+			t := time.Date(2018, time.July, 14, 0, 0, 0, 0, time.UTC)
+			days := 400 * 365
+			Expect(Period{Seconds: days * 86400}.AddTo(t)).To(Equal(t.AddDate(0, 0, days)))
+			Expect(Period{Hours: days * 24, Nanoseconds: -1}.AddTo(t)).To(Equal(t.AddDate(0, 0, days).Add(-1)))
+		})
+	})
+
+	Describe("encoding", func() {
+		It("should round trip JSON", func() {
+			var v struct{ Interval Period }
+			in := `{"Interval":"P1M"}`
+			Expect(json.Unmarshal([]byte(in), &v)).To(Succeed())
+			Expect(v.Interval).To(Equal(Period{Months: 1}))
+			Expect(json.Marshal(v)).To(Equal([]byte(in)))
+		})
+
+		It("should scan and value SQL strings", func() {
+			var p Period
+			Expect(p.Scan([]byte("P1Y"))).To(Succeed())
+			Expect(p).To(Equal(Period{Years: 1}))
+			Expect(p.Value()).To(Equal("P1Y"))
+			Expect(p.Scan(42)).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,150 @@
+package gotime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Unbounded is the number of Repetitions of a Repeating interval that never ends.
+const Unbounded = -1
+
+// Repeating represents an ISO-8601 repeating interval, eg R5/2018-07-01T00:00Z/P1W:
+// Repetitions intervals of length Period, the first of which begins at Start.
+// Each occurrence is calculated from Start rather than from the previous
+// occurrence, so monthly repetitions starting on January 31st fall on the last
+// day of each shorter month and then return to the 31st.
+type Repeating struct {
+	Repetitions int // The number of occurrences, or Unbounded
+	Start       Time
+	Period      Period
+}
+
+// ParseRepeating parses an ISO-8601 repeating interval. The number of
+// repetitions may be omitted (R/2018-07-01/P1D) for an Unbounded interval.
+// The interval can be given as start/duration or as start/end, in which case
+// the duration is the time between the two. An interval with only an end
+// (duration/end) cannot be repeated forward and is rejected.
+func ParseRepeating(str string) (Repeating, error) {
+	parts := strings.SplitN(str, "/", 2)
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "R") {
+		return Repeating{}, NewParseError(str, "Should start with R[n]/")
+	}
+
+	r := Repeating{Repetitions: Unbounded}
+	if n := parts[0][1:]; n != "" {
+		var err error
+		if r.Repetitions, err = strconv.Atoi(n); err != nil || r.Repetitions < 0 {
+			return Repeating{}, NewParseError(str, fmt.Sprintf("'%s' is not a number of repetitions", n))
+		}
+	}
+
+	sides := strings.Split(parts[1], "/")
+	if len(sides) != 2 {
+		return Repeating{}, NewParseError(str, "Should have an interval after R[n]/")
+	}
+	if isPeriod(sides[0]) {
+		return Repeating{}, NewParseError(str, "Should have a start")
+	}
+
+	var err error
+	if isPeriod(sides[1]) {
+		if r.Start, err = parseTime(sides[0]); err != nil {
+			return Repeating{}, err
+		}
+		if r.Period, err = ParsePeriod(sides[1]); err != nil {
+			return Repeating{}, err
+		}
+	} else {
+		var i Interval
+		if i, err = ParseInterval(parts[1]); err != nil {
+			return Repeating{}, err
+		}
+		d := i.Duration()
+		r.Start = i.Start
+		r.Period = Period{Seconds: int(d / time.Second), Nanoseconds: int(d % time.Second)}
+	}
+
+	if r.Period.isNegative() || r.Period.IsZero() {
+		return Repeating{}, NewParseError(str, "Should have a positive duration")
+	}
+	return r, nil
+}
+
+// String returns the repeating interval in R[n]/start/duration format.
+func (r Repeating) String() string {
+	n := ""
+	if r.Repetitions != Unbounded {
+		n = strconv.Itoa(r.Repetitions)
+	}
+	start, _ := r.Start.MarshalText() // nolint: gosec, errcheck
+	return fmt.Sprintf("R%s/%s/%s", n, start, r.Period)
+}
+
+// Occurrence returns the nth occurrence of the interval, starting from zero.
+// It does not check n against Repetitions.
+func (r Repeating) Occurrence(n int) Interval {
+	return NewInterval(r.Period.Scale(n).AddTo(r.Start.Time), r.Period.Scale(n+1).AddTo(r.Start.Time))
+}
+
+// Occurrences returns an iterator over the occurrences of the interval.
+// Use it like this:
+//
+//	for it := r.Occurrences(); it.Next(); {
+//		fmt.Println(it.Interval())
+//	}
+func (r Repeating) Occurrences() *Occurrences {
+	return &Occurrences{r: r, n: -1}
+}
+
+// Occurrences iterates over the occurrences of a Repeating interval.
+// An Unbounded interval has no end, so be sure to break out of the loop.
+type Occurrences struct {
+	r Repeating
+	n int
+}
+
+// Next advances to the next occurrence. It returns false after the last one.
+func (o *Occurrences) Next() bool {
+	if o.r.Repetitions != Unbounded && o.n+1 >= o.r.Repetitions {
+		return false
+	}
+	o.n++
+	return true
+}
+
+// Interval returns the current occurrence.
+func (o *Occurrences) Interval() Interval {
+	return o.r.Occurrence(o.n)
+}
+
+// Time returns the start of the current occurrence.
+func (o *Occurrences) Time() time.Time {
+	return o.r.Period.Scale(o.n).AddTo(o.r.Start.Time)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (r Repeating) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (r *Repeating) UnmarshalText(data []byte) error {
+	res, err := ParseRepeating(string(data))
+	if err != nil {
+		return err
+	}
+	*r = res
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r Repeating) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("\"%s\"", r)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *Repeating) UnmarshalJSON(data []byte) error {
+	return r.UnmarshalText([]byte(strings.Trim(string(data), "\"")))
+}
@@ -0,0 +1,85 @@
+package gotime_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime"
+)
+
+var _ = Describe("Repeating", func() {
+	starts := func(r Repeating, limit int) []time.Time {
+		var res []time.Time
+		for it := r.Occurrences(); it.Next() && len(res) < limit; {
+			res = append(res, it.Time())
+		}
+		return res
+	}
+
+	It("should repeat a period n times", func() {
+		r, err := ParseRepeating("R5/2018-07-01T00:00:00Z/P1W")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Repetitions).To(Equal(5))
+		Expect(r.String()).To(Equal("R5/2018-07-01T00:00:00Z/P1W"))
+
+		res := starts(r, 10)
+		Expect(res).To(HaveLen(5))
+		Expect(res[4]).To(Equal(time.Date(2018, time.July, 29, 0, 0, 0, 0, time.UTC)))
+	})
+
+	It("should repeat forever", func() {
+		r, err := ParseRepeating("R/2018-07-01/P1D")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Repetitions).To(Equal(Unbounded))
+		Expect(starts(r, 1000)).To(HaveLen(1000))
+		Expect(r.String()).To(Equal("R/2018-07-01/P1D"))
+	})
+
+	It("should clamp monthly occurrences to the end of the month", func() {
+		r, err := ParseRepeating("R4/2018-01-31/P1M")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(starts(r, 10)).To(Equal([]time.Time{
+			time.Date(2018, time.January, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2018, time.February, 28, 0, 0, 0, 0, time.UTC),
+			time.Date(2018, time.March, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2018, time.April, 30, 0, 0, 0, 0, time.UTC),
+		}))
+	})
+
+	It("should keep the wall clock across daylight savings time", func() {
+		chicago, err := time.LoadLocation("America/Chicago")
+		Expect(err).NotTo(HaveOccurred())
+		r := Repeating{Repetitions: 3, Start: Time{Time: time.Date(2018, time.March, 10, 9, 0, 0, 0, chicago)},
+			Period: Period{Days: 1}}
+		for _, t := range starts(r, 3) {
+			Expect(t.In(chicago).Hour()).To(Equal(9))
+		}
+	})
+
+	It("should accept start/end intervals", func() {
+		r, err := ParseRepeating("R2/2018-07-01T09:00:00Z/2018-07-01T17:30:00Z")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Period.Clock()).To(Equal(8*time.Hour + 30*time.Minute))
+		Expect(r.Occurrence(1).Start.Time).To(Equal(time.Date(2018, time.July, 1, 17, 30, 0, 0, time.UTC)))
+	})
+
+	var problems = map[string]string{
+		"2018-07-01/P1D":     "Should start with R[n]/",
+		"Rx/2018-07-01/P1D":  "'x' is not a number of repetitions",
+		"R-1/2018-07-01/P1D": "'-1' is not a number of repetitions",
+		"R5/2018-07-01":      "Should have an interval after R[n]/",
+		"R5/P1D/2018-07-01":  "Should have a start",
+		"R5/2018-07-01/PT0S": "Should have a positive duration",
+		"R5/2018-07-01/-P1D": "Should have a positive duration",
+	}
+	for str, problem := range problems {
+		str, problem := str, problem
+		It("should reject "+str, func() {
+			_, err := ParseRepeating(str)
+			Expect(err).To(BeAssignableToTypeOf(&ParseError{}))
+			Expect(err.(*ParseError).Problem).To(Equal(problem))
+		})
+	}
+})
@@ -0,0 +1,391 @@
+package rrule
+
+import (
+	"time"
+)
+
+// maxYear ends rules whose BYxxx parts can never be satisfied, such as
+// FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30, rather than searching forever.
+const maxYear = 9999
+
+// maxEmptyPeriods ends rules that go this many periods in a row without an
+// occurrence. Searching until maxYear would take billions of periods for an
+// unsatisfiable SECONDLY or MINUTELY rule, such as FREQ=SECONDLY;INTERVAL=2;
+// BYSECOND=1 starting on an even second. Periods in days, hours, and minutes
+// that cannot match are skipped together, so that rules that are merely rare,
+// such as every Feb 29 that is a Monday, fit well within the limit.
+const maxEmptyPeriods = 100000
+
+// ruleIterator generates the occurrences of a rule one period at a time.
+type ruleIterator struct {
+	r      Rule
+	period int         // The index of the next period to expand
+	buf    []time.Time // Occurrences from the current period not yet returned
+	count  int         // The number of occurrences returned so far
+	done   bool
+}
+
+func newRuleIterator(r Rule) *ruleIterator {
+	if r.Interval < 1 {
+		r.Interval = 1
+	}
+	return &ruleIterator{r: r}
+}
+
+// next returns the next occurrence, applying DTSTART, COUNT, and UNTIL.
+func (it *ruleIterator) next() (time.Time, bool) {
+	for !it.done {
+		if len(it.buf) == 0 {
+			it.fill()
+			continue
+		}
+
+		t := it.buf[0]
+		it.buf = it.buf[1:]
+		switch {
+		case t.Before(it.r.Start):
+			continue
+		case !it.r.Until.IsZero() && t.After(it.r.Until),
+			it.r.Count != 0 && it.count >= it.r.Count:
+			it.done = true
+			continue
+		}
+		it.count++
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// fill expands periods until one of them has occurrences or the rule ends.
+func (it *ruleIterator) fill() {
+	for empty := 0; len(it.buf) == 0 && !it.done; empty++ {
+		if empty == maxEmptyPeriods {
+			it.done = true
+			return
+		}
+		if it.r.Freq < Daily {
+			it.buf = it.expandClock()
+		} else {
+			it.buf = it.expandDays()
+		}
+		it.period++
+	}
+}
+
+// expandDays returns the occurrences in the current period of a DAILY or
+// less frequent rule.
+func (it *ruleIterator) expandDays() []time.Time {
+	r, k := &it.r, it.period*it.r.Interval
+	y, m, d := r.Start.Date()
+
+	var first, last time.Time // The period is [first, last)
+	weekYear := y
+	switch r.Freq {
+	case Yearly:
+		weekYear = y + k
+		first, last = date(weekYear, time.January, 1), date(weekYear+1, time.January, 1)
+		if len(r.ByWeekNo) > 0 {
+			first, last = weekOne(weekYear, r.WeekStart), weekOne(weekYear+1, r.WeekStart)
+		}
+	case Monthly:
+		first = date(y, m+time.Month(k), 1)
+		last = first.AddDate(0, 1, 0)
+	case Weekly:
+		start := date(y, m, d)
+		first = start.AddDate(0, 0, 7*k-daysSinceWeekStart(start, r.WeekStart))
+		last = first.AddDate(0, 0, 7)
+	default:
+		first = date(y, m, d+k)
+		last = first.AddDate(0, 0, 1)
+	}
+	if it.pastEnd(first) {
+		return nil
+	}
+
+	hours := orDefault(r.ByHour, r.Start.Hour())
+	minutes := orDefault(r.ByMinute, r.Start.Minute())
+	seconds := orDefault(r.BySecond, r.Start.Second())
+
+	var res []time.Time
+	for day := first; day.Before(last); day = day.AddDate(0, 0, 1) {
+		if !r.matches(day, weekYear) {
+			continue
+		}
+		y, m, d := day.Date()
+		for _, hh := range hours {
+			for _, mm := range minutes {
+				for _, ss := range seconds {
+					res = append(res, time.Date(y, m, d, hh, mm, ss, r.Start.Nanosecond(), r.Start.Location()))
+				}
+			}
+		}
+	}
+	return r.setPos(res)
+}
+
+// expandClock returns the occurrences in the current period of an HOURLY,
+// MINUTELY, or SECONDLY rule. These periods are elapsed time rather than
+// wall clock time, so an hourly rule does not skip or repeat an hour when
+// daylight savings time begins or ends.
+func (it *ruleIterator) expandClock() []time.Time {
+	r := &it.r
+	y, m, d := r.Start.Date()
+	hh, mm, ss := r.Start.Clock()
+
+	var base time.Time
+	var unit time.Duration
+	switch r.Freq {
+	case Hourly:
+		base, unit = time.Date(y, m, d, hh, 0, 0, 0, r.Start.Location()), time.Hour
+	case Minutely:
+		base, unit = time.Date(y, m, d, hh, mm, 0, 0, r.Start.Location()), time.Minute
+	default:
+		base, unit = time.Date(y, m, d, hh, mm, ss, 0, r.Start.Location()), time.Second
+	}
+	step := time.Duration(r.Interval) * unit
+	t := base.Add(time.Duration(it.period) * step)
+	if it.pastEnd(t) {
+		return nil
+	}
+
+	// Skip to the next day, hour, or minute when this one does not match,
+	// since none of its periods will
+	y, m, d = t.Date()
+	hh, mm, ss = t.Clock()
+	switch {
+	case !r.matches(date(y, m, d), y):
+		it.skipTo(t, time.Date(y, m, d+1, 0, 0, 0, 0, t.Location()), step)
+		return nil
+	case !contains(r.ByHour, hh):
+		it.skipTo(t, t.Add(time.Duration(60-mm)*time.Minute-time.Duration(ss)*time.Second), step)
+		return nil
+	case r.Freq < Hourly && !contains(r.ByMinute, mm):
+		it.skipTo(t, t.Add(time.Duration(60-ss)*time.Second), step)
+		return nil
+	case r.Freq < Minutely && !contains(r.BySecond, ss):
+		return nil
+	}
+
+	minutes, seconds := []int{mm}, []int{ss}
+	if r.Freq == Hourly {
+		minutes = orDefault(r.ByMinute, r.Start.Minute())
+	}
+	if r.Freq > Secondly {
+		seconds = orDefault(r.BySecond, r.Start.Second())
+	}
+
+	var res []time.Time
+	for _, m := range minutes {
+		for _, s := range seconds {
+			offset := time.Duration(m-mm)*time.Minute + time.Duration(s-ss)*time.Second
+			res = append(res, t.Add(offset+time.Duration(r.Start.Nanosecond())))
+		}
+	}
+	return r.setPos(res)
+}
+
+// skipTo skips the periods after the one starting at t that start before next.
+func (it *ruleIterator) skipTo(t, next time.Time, step time.Duration) {
+	if skip := int((next.Sub(t)+step-1)/step) - 1; skip > 0 {
+		it.period += skip
+	}
+}
+
+// pastEnd determines whether a period starting at t, which is a day for
+// DAILY or less frequent rules, is after the UNTIL or the last supported year.
+// It ends the iteration if so.
+func (it *ruleIterator) pastEnd(t time.Time) bool {
+	if t.Year() > maxYear {
+		it.done = true
+	} else if until := it.r.Until; !until.IsZero() {
+		if it.r.Freq >= Daily {
+			y, m, d := until.In(it.r.Start.Location()).Date()
+			until = date(y, m, d)
+		}
+		it.done = t.After(until)
+	}
+	return it.done
+}
+
+// matches determines whether a day satisfies the date parts of the rule,
+// including the parts implied by the Start when the rule has none.
+// The day must be midnight UTC. weekYear is the year BYWEEKNO refers to.
+func (r *Rule) matches(day time.Time, weekYear int) bool {
+	y, m, d := day.Date()
+	if len(r.ByMonth) > 0 && !containsMonth(r.ByMonth, m) {
+		return false
+	}
+	if len(r.ByWeekNo) > 0 && r.Freq == Yearly {
+		start, end := weekOne(weekYear, r.WeekStart), weekOne(weekYear+1, r.WeekStart)
+		week := daysBetween(start, day)/7 + 1
+		if !containsSigned(r.ByWeekNo, week, daysBetween(start, end)/7) {
+			return false
+		}
+	}
+	if len(r.ByYearDay) > 0 && !containsSigned(r.ByYearDay, day.YearDay(), daysIn(y, 0)) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 && !containsSigned(r.ByMonthDay, d, daysIn(y, m)) {
+		return false
+	}
+	if len(r.ByDay) > 0 && !r.matchesWeekday(day) {
+		return false
+	}
+
+	// Without parts that say which days to use, the rule falls on the Start's day
+	switch r.Freq {
+	case Yearly:
+		if len(r.ByWeekNo)+len(r.ByYearDay)+len(r.ByMonthDay)+len(r.ByDay) == 0 {
+			return d == r.Start.Day() && (len(r.ByMonth) > 0 || m == r.Start.Month())
+		}
+	case Monthly:
+		if len(r.ByYearDay)+len(r.ByMonthDay)+len(r.ByDay) == 0 {
+			return d == r.Start.Day()
+		}
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			return day.Weekday() == r.Start.Weekday()
+		}
+	}
+	return true
+}
+
+// matchesWeekday determines whether a day is in BYDAY. An ordinal such as
+// -1FR counts within the month for MONTHLY rules and YEARLY rules with
+// BYMONTH, and within the year for other YEARLY rules. Other frequencies
+// ignore the ordinal.
+func (r *Rule) matchesWeekday(day time.Time) bool {
+	y, m, d := day.Date()
+	for _, wd := range r.ByDay {
+		if wd.Day != day.Weekday() {
+			continue
+		}
+
+		var n, total int
+		switch {
+		case wd.N == 0:
+			return true
+		case r.Freq == Monthly || (r.Freq == Yearly && len(r.ByMonth) > 0):
+			n, total = d, daysIn(y, m)
+		case r.Freq == Yearly:
+			n, total = day.YearDay(), daysIn(y, 0)
+		default:
+			return true
+		}
+		if wd.N == (n-1)/7+1 || wd.N == -((total-n)/7+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// setPos applies BYSETPOS to the occurrences in a period, which are
+// also sorted and made unique.
+func (r *Rule) setPos(times []time.Time) []time.Time {
+	sortTimes(times)
+	times = unique(times)
+	if len(r.BySetPos) == 0 {
+		return times
+	}
+
+	var res []time.Time
+	for _, pos := range r.BySetPos {
+		idx := pos - 1
+		if pos < 0 {
+			idx = len(times) + pos
+		}
+		if idx >= 0 && idx < len(times) {
+			res = append(res, times[idx])
+		}
+	}
+	sortTimes(res)
+	return unique(res)
+}
+
+// unique removes consecutive duplicates from a sorted slice.
+func unique(times []time.Time) []time.Time {
+	res := times[:0]
+	for i, t := range times {
+		if i == 0 || !t.Equal(times[i-1]) {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// weekOne returns the first day of week 1 of a year, which is the first
+// week starting on wkst with at least four days in the year.
+func weekOne(year int, wkst time.Weekday) time.Time {
+	jan1 := date(year, time.January, 1)
+	offset := daysSinceWeekStart(jan1, wkst)
+	if offset > 3 {
+		offset -= 7
+	}
+	return jan1.AddDate(0, 0, -offset)
+}
+
+// daysSinceWeekStart returns the number of days from the last wkst to t.
+func daysSinceWeekStart(t time.Time, wkst time.Weekday) int {
+	return (int(t.Weekday()) - int(wkst) + 7) % 7
+}
+
+// date returns midnight UTC on a date. Days are calculated in UTC so that
+// every one of them is 24 hours long.
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the number of days from a to b, both of which are midnight UTC.
+func daysBetween(a, b time.Time) int {
+	return int(b.Sub(a) / (24 * time.Hour))
+}
+
+// daysIn returns the number of days in a month, or in the year if month is zero.
+func daysIn(year int, month time.Month) int {
+	if month == 0 {
+		return date(year, time.December, 31).YearDay()
+	}
+	return date(year, month+1, 0).Day()
+}
+
+// orDefault returns the values of a BYxxx part, or def if it is empty.
+func orDefault(values []int, def int) []int {
+	if len(values) == 0 {
+		return []int{def}
+	}
+	return values
+}
+
+// contains determines whether a BYxxx part allows a value. Every value is
+// allowed if the part is empty.
+func contains(values []int, v int) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// containsSigned determines whether a BYxxx part with negative values counting
+// from the end contains n, where total is the number of possible values.
+func containsSigned(values []int, n, total int) bool {
+	for _, x := range values {
+		if x == n || (x < 0 && total+1+x == n) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsMonth(months []time.Month, m time.Month) bool {
+	for _, x := range months {
+		if x == m {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,265 @@
+package rrule
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/onwsk8r/gotime"
+)
+
+// ParseRule parses an RRULE value such as FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE,
+// with or without the RRULE: prefix. The rule has no Start, so be sure to set
+// one before using it, or use ParseSet to parse the rule along with its DTSTART.
+// An UNTIL without an offset is taken to be in UTC.
+func ParseRule(str string) (Rule, error) {
+	return parseRule(str, time.UTC)
+}
+
+// byDay matches an entry in BYDAY, eg MO, 2TU, or -1FR.
+var byDay = regexp.MustCompile(`^([+-]?\d{1,2})?(SU|MO|TU|WE|TH|FR|SA)$`)
+
+// parseRule parses an RRULE value, taking an UNTIL without an offset to be in loc.
+func parseRule(str string, loc *time.Location) (Rule, error) {
+	r := Rule{Freq: -1, WeekStart: time.Monday}
+	value := strings.TrimPrefix(str, "RRULE:")
+	for _, part := range strings.Split(value, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return Rule{}, gotime.NewParseError(str, fmt.Sprintf("'%s' should be NAME=VALUE", part))
+		}
+
+		var err error
+		name, val := strings.ToUpper(kv[0]), kv[1]
+		switch name {
+		case "FREQ":
+			r.Freq = Frequency(index(frequencies, strings.ToUpper(val)))
+			if r.Freq < 0 {
+				err = errors.Errorf("'%s' is not a frequency", val)
+			}
+		case "INTERVAL":
+			r.Interval, err = parseInt(name, val, 1, 0)
+		case "COUNT":
+			r.Count, err = parseInt(name, val, 1, 0)
+		case "UNTIL":
+			r.Until, err = parseDateTime(val, loc)
+		case "BYMONTH":
+			var months []int
+			months, err = parseInts(name, val, 1, 12, false)
+			for _, m := range months {
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		case "BYWEEKNO":
+			r.ByWeekNo, err = parseInts(name, val, 1, 53, true)
+		case "BYYEARDAY":
+			r.ByYearDay, err = parseInts(name, val, 1, 366, true)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(name, val, 1, 31, true)
+		case "BYDAY":
+			r.ByDay, err = parseWeekdays(strings.ToUpper(val))
+		case "BYHOUR":
+			r.ByHour, err = parseInts(name, val, 0, 23, false)
+		case "BYMINUTE":
+			r.ByMinute, err = parseInts(name, val, 0, 59, false)
+		case "BYSECOND":
+			r.BySecond, err = parseInts(name, val, 0, 60, false)
+		case "BYSETPOS":
+			r.BySetPos, err = parseInts(name, val, 1, 366, true)
+		case "WKST":
+			if wd := index(weekdays, strings.ToUpper(val)); wd < 0 {
+				err = errors.Errorf("'%s' is not a weekday", val)
+			} else {
+				r.WeekStart = time.Weekday(wd)
+			}
+		default:
+			err = errors.Errorf("Unknown rule part %s", name)
+		}
+		if err != nil {
+			return Rule{}, gotime.NewParseError(str, err.Error())
+		}
+	}
+
+	switch {
+	case r.Freq < 0:
+		return Rule{}, gotime.NewParseError(str, "Should have a FREQ")
+	case r.Count != 0 && !r.Until.IsZero():
+		return Rule{}, gotime.NewParseError(str, "Should not have both COUNT and UNTIL")
+	}
+	return r, nil
+}
+
+// index returns the index of str in list, or -1 if it is not there.
+func index(list []string, str string) int {
+	for i, s := range list {
+		if s == str {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseInt parses the value of a rule part, which must be at least min and,
+// unless max is zero, at most max.
+func parseInt(name, str string, min, max int) (int, error) {
+	n, err := strconv.Atoi(str)
+	if err != nil {
+		return 0, errors.Errorf("%s '%s' is not a number", name, str)
+	}
+	if n < min || (max != 0 && n > max) {
+		return 0, errors.Errorf("%s %d is out of range", name, n)
+	}
+	return n, nil
+}
+
+// parseInts parses a comma-separated list of numbers from min to max.
+// If signed is true, the numbers may also be from -max to -min.
+func parseInts(name, str string, min, max int, signed bool) ([]int, error) {
+	var res []int
+	for _, s := range strings.Split(str, ",") {
+		n, err := parseInt(name, strings.TrimPrefix(s, "-"), min, max)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(s, "-") {
+			if !signed {
+				return nil, errors.Errorf("%s %s is out of range", name, s)
+			}
+			n = -n
+		}
+		res = append(res, n)
+	}
+	return res, nil
+}
+
+// parseWeekdays parses the value of BYDAY.
+func parseWeekdays(str string) ([]Weekday, error) {
+	var res []Weekday
+	for _, s := range strings.Split(str, ",") {
+		m := byDay.FindStringSubmatch(s)
+		if m == nil {
+			return nil, errors.Errorf("'%s' is not a weekday", s)
+		}
+
+		wd := Weekday{Day: time.Weekday(index(weekdays, m[2]))}
+		if m[1] != "" {
+			n, _ := strconv.Atoi(strings.TrimPrefix(m[1], "+")) // nolint: gosec, errcheck
+			if n == 0 || n < -53 || n > 53 {
+				return nil, errors.Errorf("BYDAY %s is out of range", s)
+			}
+			wd.N = n
+		}
+		res = append(res, wd)
+	}
+	return res, nil
+}
+
+// parseDateTime parses an iCalendar DATE or DATE-TIME value, eg 19970902 or
+// 19970902T090000Z, with gotime.Parse. Values without an offset are taken
+// to be in loc.
+func parseDateTime(str string, loc *time.Location) (time.Time, error) {
+	t, err := gotime.Parse(str)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if strings.HasSuffix(str, "Z") {
+		return t, nil
+	}
+	y, m, d := t.Date()
+	hh, mm, ss := t.Clock()
+	return time.Date(y, m, d, hh, mm, ss, t.Nanosecond(), loc), nil
+}
+
+// ParseSet parses the recurrence of an iCalendar event: a DTSTART line and
+// any number of RRULE, RDATE, and EXDATE lines, eg
+//
+//	DTSTART;TZID=America/New_York:19970902T090000
+//	RRULE:FREQ=WEEKLY;COUNT=10
+//	EXDATE:19970909T090000
+//
+// Times without an offset are taken to be in the TZID of their line if it has
+// one, and otherwise in the location of the DTSTART. Each rule's Start is the
+// DTSTART. Lines with other properties, such as SUMMARY, are ignored, as is the
+// duration of an RDATE period.
+func ParseSet(str string) (Set, error) {
+	// Lines beginning with a space or tab continue the previous line
+	str = strings.NewReplacer("\r\n ", "", "\r\n\t", "", "\n ", "", "\n\t", "").Replace(str)
+
+	type property struct {
+		name, value string
+		loc         *time.Location // The TZID, if there is one
+	}
+	var props []property
+	var start time.Time
+	for _, line := range strings.Split(str, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		idx := strings.Index(line, ":")
+		if idx < 0 {
+			return Set{}, gotime.NewParseError(line, "Should be NAME:VALUE")
+		}
+
+		params := strings.Split(line[:idx], ";")
+		p := property{name: strings.ToUpper(params[0]), value: line[idx+1:]}
+		for _, param := range params[1:] {
+			if strings.HasPrefix(strings.ToUpper(param), "TZID=") {
+				var err error
+				if p.loc, err = time.LoadLocation(param[5:]); err != nil {
+					return Set{}, gotime.NewParseError(line, fmt.Sprintf("Unknown TZID %s", param[5:]))
+				}
+			}
+		}
+
+		if p.name == "DTSTART" {
+			if p.loc == nil {
+				p.loc = time.UTC
+			}
+			var err error
+			if start, err = parseDateTime(p.value, p.loc); err != nil {
+				return Set{}, err
+			}
+		}
+		props = append(props, p)
+	}
+
+	var s Set
+	var rules []string
+	for _, p := range props {
+		if p.loc == nil {
+			p.loc = start.Location()
+		}
+		switch p.name {
+		case "RRULE":
+			rules = append(rules, p.value)
+		case "RDATE", "EXDATE":
+			for _, v := range strings.Split(p.value, ",") {
+				t, err := parseDateTime(strings.SplitN(v, "/", 2)[0], p.loc)
+				if err != nil {
+					return Set{}, err
+				}
+				if p.name == "RDATE" {
+					s.RDates = append(s.RDates, t)
+				} else {
+					s.ExDates = append(s.ExDates, t)
+				}
+			}
+		}
+	}
+
+	if len(rules) > 0 && start.IsZero() {
+		return Set{}, gotime.NewParseError(str, "Should have a DTSTART")
+	}
+	for _, rule := range rules {
+		r, err := parseRule(rule, start.Location())
+		if err != nil {
+			return Set{}, err
+		}
+		r.Start = start
+		s.Rules = append(s.Rules, r)
+	}
+	return s, nil
+}
@@ -0,0 +1,121 @@
+package rrule_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onwsk8r/gotime"
+	. "github.com/onwsk8r/gotime/rrule"
+)
+
+var _ = Describe("ParseRule", func() {
+	It("should parse every rule part", func() {
+		r, err := ParseRule("RRULE:FREQ=YEARLY;INTERVAL=2;UNTIL=20001231T235959Z;BYMONTH=1,12;" +
+			"BYWEEKNO=1,-1;BYYEARDAY=-1;BYMONTHDAY=1,-1;BYDAY=MO,-1FR,+2SU;BYHOUR=9;BYMINUTE=30;" +
+			"BYSECOND=0;BYSETPOS=-1;WKST=SU")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(r).To(Equal(Rule{
+			Freq:       Yearly,
+			Interval:   2,
+			Until:      time.Date(2000, time.December, 31, 23, 59, 59, 0, time.UTC),
+			ByMonth:    []time.Month{time.January, time.December},
+			ByWeekNo:   []int{1, -1},
+			ByYearDay:  []int{-1},
+			ByMonthDay: []int{1, -1},
+			ByDay:      []Weekday{{time.Monday, 0}, {time.Friday, -1}, {time.Sunday, 2}},
+			ByHour:     []int{9},
+			ByMinute:   []int{30},
+			BySecond:   []int{0},
+			BySetPos:   []int{-1},
+			WeekStart:  time.Sunday,
+		}))
+	})
+
+	It("should default WKST to Monday", func() {
+		r, err := ParseRule("FREQ=WEEKLY")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(r.WeekStart).To(Equal(time.Monday))
+	})
+
+	It("should format a rule the way it was parsed", func() {
+		for _, str := range []string{
+			"FREQ=DAILY;COUNT=10",
+			"FREQ=WEEKLY;INTERVAL=2;UNTIL=19971224T000000Z;BYDAY=TU,TH;WKST=SU",
+			"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			"FREQ=YEARLY;BYMONTH=11;BYMONTHDAY=2,3,4,5,6,7,8;BYDAY=TU",
+		} {
+			r, err := ParseRule(str)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r.String()).To(Equal(str))
+		}
+	})
+
+	var problems = map[string]string{
+		"INTERVAL=2":                        "Should have a FREQ",
+		"FREQ=FORTNIGHTLY":                  "'FORTNIGHTLY' is not a frequency",
+		"FREQ=DAILY;COUNT=1;UNTIL=19970902": "Should not have both COUNT and UNTIL",
+		"FREQ=DAILY;COUNT":                  "'COUNT' should be NAME=VALUE",
+		"FREQ=DAILY;INTERVAL=0":             "INTERVAL 0 is out of range",
+		"FREQ=DAILY;COUNT=x":                "COUNT 'x' is not a number",
+		"FREQ=YEARLY;BYMONTH=13":            "BYMONTH 13 is out of range",
+		"FREQ=DAILY;BYHOUR=-1":              "BYHOUR -1 is out of range",
+		"FREQ=MONTHLY;BYMONTHDAY=0":         "BYMONTHDAY 0 is out of range",
+		"FREQ=MONTHLY;BYDAY=6FRI":           "'6FRI' is not a weekday",
+		"FREQ=YEARLY;BYDAY=54MO":            "BYDAY 54MO is out of range",
+		"FREQ=WEEKLY;WKST=XX":               "'XX' is not a weekday",
+		"FREQ=WEEKLY;BYEASTER=1":            "Unknown rule part BYEASTER",
+	}
+	for str, problem := range problems {
+		str, problem := str, problem
+		It("should reject "+str, func() {
+			_, err := ParseRule(str)
+			Expect(err).To(BeAssignableToTypeOf(&gotime.ParseError{}))
+			Expect(err.(*gotime.ParseError).Problem).To(Equal(problem))
+		})
+	}
+})
+
+var _ = Describe("ParseSet", func() {
+	It("should parse a DTSTART with a TZID along with its rules and dates", func() {
+		s, err := ParseSet("DTSTART;TZID=America/New_York:19970902T090000\r\n" +
+			"RRULE:FREQ=WEEKLY;\r\n COUNT=3\r\n" +
+			"RDATE:19970910T090000,19970911T130000Z\r\n" +
+			"EXDATE:19970909T090000\r\n" +
+			"SUMMARY:Staff meeting\r\n")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Rules).To(HaveLen(1))
+		Expect(s.Rules[0].Start).To(Equal(times("19970902T090000")[0]))
+		Expect(s.Rules[0].Count).To(Equal(3))
+		Expect(s.RDates).To(Equal([]time.Time{times("19970910T090000")[0],
+			time.Date(1997, time.September, 11, 13, 0, 0, 0, time.UTC)}))
+		Expect(s.ExDates).To(Equal(times("19970909T090000")))
+	})
+
+	It("should take an UNTIL without an offset to be in the DTSTART's location", func() {
+		s, err := ParseSet("DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=DAILY;UNTIL=19970904T090000")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Rules[0].Until).To(Equal(times("19970904T090000")[0]))
+		Expect(s.All()).To(HaveLen(3))
+	})
+
+	It("should accept dates without times", func() {
+		s, err := ParseSet("DTSTART:20180101\nRRULE:FREQ=YEARLY;COUNT=2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.All()).To(Equal([]time.Time{time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)}))
+	})
+
+	It("should reject rules without a DTSTART", func() {
+		_, err := ParseSet("RRULE:FREQ=DAILY")
+		Expect(err).To(BeAssignableToTypeOf(&gotime.ParseError{}))
+		Expect(err.(*gotime.ParseError).Problem).To(Equal("Should have a DTSTART"))
+	})
+
+	It("should reject an unknown TZID", func() {
+		_, err := ParseSet("DTSTART;TZID=Mars/Olympus_Mons:19970902T090000")
+		Expect(err).To(BeAssignableToTypeOf(&gotime.ParseError{}))
+		Expect(err.(*gotime.ParseError).Problem).To(Equal("Unknown TZID Mars/Olympus_Mons"))
+	})
+})
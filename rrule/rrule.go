@@ -0,0 +1,259 @@
+/*
+Package rrule evaluates iCalendar recurrence rules as defined in RFC 5545.
+
+A recurrence rule such as FREQ=MONTHLY;BYDAY=-1FR (the last Friday of every
+month) is represented by the Rule type, and a Set combines any number of Rules
+with individual dates to include (RDATE) or exclude (EXDATE). Both can list all
+of their occurrences, the occurrences between two times, or the occurrence
+immediately after or before a given time.
+
+Rules are evaluated the way RFC 5545 describes: each period of the rule's
+frequency (a year for FREQ=YEARLY, a month for FREQ=MONTHLY, and so on) is
+expanded into candidate dates and times using the BYxxx parts, BYSETPOS picks
+from those candidates, and COUNT and UNTIL end the recurrence. Dates that do
+not exist, such as February 30th, are skipped rather than moved. Times are
+calculated on the wall clock in the location of the rule's Start, so a
+meeting at 9am stays at 9am when daylight savings time begins.
+
+Use ParseRule to parse a single RRULE value, or ParseSet to parse the
+DTSTART, RRULE, RDATE, and EXDATE lines of an iCalendar event.
+*/
+package rrule
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of a rule: how often it recurs.
+type Frequency int
+
+// Frequencies, from the most to the least frequent.
+const (
+	Secondly Frequency = iota
+	Minutely
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencies = []string{"SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+// String returns the frequency as it appears in an RRULE, eg WEEKLY.
+func (f Frequency) String() string {
+	if f < Secondly || f > Yearly {
+		return "Frequency(" + strconv.Itoa(int(f)) + ")"
+	}
+	return frequencies[f]
+}
+
+// Weekday is an entry in BYDAY: a day of the week, optionally with an
+// ordinal. N is zero for every such day in the period, positive for the
+// Nth such day, or negative for the Nth such day from the end (-1FR is
+// the last Friday).
+type Weekday struct {
+	Day time.Weekday
+	N   int
+}
+
+var weekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// String returns the weekday as it appears in an RRULE, eg -1FR.
+func (w Weekday) String() string {
+	if w.N == 0 {
+		return weekdays[w.Day]
+	}
+	return strconv.Itoa(w.N) + weekdays[w.Day]
+}
+
+// Rule is an RFC 5545 recurrence rule. The fields correspond to the parts of
+// an RRULE, with Start being the DTSTART of the event the rule belongs to.
+// Empty fields are not part of the rule. Note that the zero value of
+// WeekStart is Sunday; ParseRule sets it to Monday unless WKST says otherwise,
+// as RFC 5545 requires.
+type Rule struct {
+	Freq       Frequency
+	Start      time.Time
+	Interval   int       // Zero is the same as 1
+	Count      int       // Zero means there is no COUNT
+	Until      time.Time // The zero time means there is no UNTIL
+	ByMonth    []time.Month
+	ByWeekNo   []int
+	ByYearDay  []int
+	ByMonthDay []int
+	ByDay      []Weekday
+	ByHour     []int
+	ByMinute   []int
+	BySecond   []int
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// String returns the rule as an RRULE value, without the RRULE: prefix or
+// the Start. UNTIL is written in UTC.
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count != 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	months := make([]int, len(r.ByMonth))
+	for i, m := range r.ByMonth {
+		months[i] = int(m)
+	}
+	days := make([]string, len(r.ByDay))
+	for i, d := range r.ByDay {
+		days[i] = d.String()
+	}
+	for _, part := range []struct {
+		name   string
+		values []int
+	}{
+		{"BYMONTH", months},
+		{"BYWEEKNO", r.ByWeekNo},
+		{"BYYEARDAY", r.ByYearDay},
+		{"BYMONTHDAY", r.ByMonthDay},
+		{"BYDAY", nil},
+		{"BYHOUR", r.ByHour},
+		{"BYMINUTE", r.ByMinute},
+		{"BYSECOND", r.BySecond},
+		{"BYSETPOS", r.BySetPos},
+	} {
+		if part.name == "BYDAY" && len(days) > 0 {
+			parts = append(parts, "BYDAY="+strings.Join(days, ","))
+		} else if len(part.values) > 0 {
+			parts = append(parts, part.name+"="+joinInts(part.values))
+		}
+	}
+
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdays[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+// joinInts formats a list of integers as comma-separated values.
+func joinInts(values []int) string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = strconv.Itoa(v)
+	}
+	return strings.Join(strs, ",")
+}
+
+// Iterator returns an iterator over the occurrences of the rule.
+func (r Rule) Iterator() *Iterator {
+	it := newRuleIterator(r)
+	return &Iterator{next: it.next}
+}
+
+// All returns every occurrence of the rule. Rules without a COUNT or an
+// UNTIL never end, so All stops after MaxOccurrences.
+func (r Rule) All() []time.Time {
+	return all(r.Iterator())
+}
+
+// Between returns the occurrences of the rule after <after> and before <before>.
+// If inclusive is true, occurrences equal to either of them are included as well.
+func (r Rule) Between(after, before time.Time, inclusive bool) []time.Time {
+	return between(r.Iterator(), after, before, inclusive)
+}
+
+// After returns the first occurrence of the rule after t, or the zero
+// time if there is none. If inclusive is true, an occurrence equal to t
+// is returned as well.
+func (r Rule) After(t time.Time, inclusive bool) time.Time {
+	return after(r.Iterator(), t, inclusive)
+}
+
+// Before returns the last occurrence of the rule before t, or the zero
+// time if there is none. If inclusive is true, an occurrence equal to t
+// is returned as well.
+func (r Rule) Before(t time.Time, inclusive bool) time.Time {
+	return before(r.Iterator(), t, inclusive)
+}
+
+// MaxOccurrences limits the number of occurrences All returns for a rule
+// or set that never ends.
+var MaxOccurrences = 100000
+
+// Iterator iterates over the occurrences of a Rule or a Set in order.
+// Use it like this:
+//
+//	for it := rule.Iterator(); it.Next(); {
+//		fmt.Println(it.Time())
+//	}
+type Iterator struct {
+	next func() (time.Time, bool)
+	cur  time.Time
+}
+
+// Next advances to the next occurrence. It returns false after the last one.
+func (it *Iterator) Next() bool {
+	var ok bool
+	it.cur, ok = it.next()
+	return ok
+}
+
+// Time returns the current occurrence.
+func (it *Iterator) Time() time.Time {
+	return it.cur
+}
+
+func all(it *Iterator) []time.Time {
+	var res []time.Time
+	for len(res) < MaxOccurrences && it.Next() {
+		res = append(res, it.Time())
+	}
+	return res
+}
+
+func between(it *Iterator, a, b time.Time, inclusive bool) []time.Time {
+	var res []time.Time
+	for it.Next() {
+		t := it.Time()
+		if t.After(b) || (!inclusive && t.Equal(b)) {
+			break
+		}
+		if t.After(a) || (inclusive && t.Equal(a)) {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func after(it *Iterator, a time.Time, inclusive bool) time.Time {
+	for it.Next() {
+		if t := it.Time(); t.After(a) || (inclusive && t.Equal(a)) {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func before(it *Iterator, b time.Time, inclusive bool) time.Time {
+	var res time.Time
+	for it.Next() {
+		t := it.Time()
+		if t.After(b) || (!inclusive && t.Equal(b)) {
+			break
+		}
+		res = t
+	}
+	return res
+}
+
+// sortTimes sorts a slice of times in place.
+func sortTimes(times []time.Time) {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+}
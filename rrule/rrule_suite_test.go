@@ -0,0 +1,13 @@
+package rrule_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRrule(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rrule Suite")
+}
@@ -0,0 +1,170 @@
+package rrule_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/rrule"
+)
+
+var newYork, _ = time.LoadLocation("America/New_York")
+
+// times parses iCalendar date-times in New York.
+func times(strs ...string) []time.Time {
+	res := make([]time.Time, len(strs))
+	for i, str := range strs {
+		t, err := time.ParseInLocation("20060102T150405", str, newYork)
+		Expect(err).ToNot(HaveOccurred())
+		res[i] = t
+	}
+	return res
+}
+
+// rule parses an RRULE starting at a date-time in New York.
+func rule(start, str string) Rule {
+	r, err := ParseRule(str)
+	Expect(err).ToNot(HaveOccurred())
+	r.Start = times(start)[0]
+	return r
+}
+
+var _ = Describe("Rule", func() {
+	// Most of these are the examples from RFC 5545 section 3.8.5.3
+	var examples = []struct {
+		start, rule string
+		expected    []string
+	}{
+		{"19970902T090000", "FREQ=DAILY;COUNT=10", []string{"19970902T090000", "19970903T090000",
+			"19970904T090000", "19970905T090000", "19970906T090000", "19970907T090000",
+			"19970908T090000", "19970909T090000", "19970910T090000", "19970911T090000"}},
+		{"19970902T090000", "FREQ=DAILY;INTERVAL=2;COUNT=5", []string{"19970902T090000",
+			"19970904T090000", "19970906T090000", "19970908T090000", "19970910T090000"}},
+		{"19970902T090000", "FREQ=WEEKLY;COUNT=10", []string{"19970902T090000", "19970909T090000",
+			"19970916T090000", "19970923T090000", "19970930T090000", "19971007T090000",
+			"19971014T090000", "19971021T090000", "19971028T090000", "19971104T090000"}},
+		{"19970902T090000", "FREQ=WEEKLY;INTERVAL=2;COUNT=8;WKST=SU;BYDAY=TU,TH", []string{
+			"19970902T090000", "19970904T090000", "19970916T090000", "19970918T090000",
+			"19970930T090000", "19971002T090000", "19971014T090000", "19971016T090000"}},
+		{"19970805T090000", "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO", []string{
+			"19970805T090000", "19970810T090000", "19970819T090000", "19970824T090000"}},
+		{"19970805T090000", "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU", []string{
+			"19970805T090000", "19970817T090000", "19970819T090000", "19970831T090000"}},
+		{"19970905T090000", "FREQ=MONTHLY;COUNT=6;BYDAY=1FR", []string{"19970905T090000",
+			"19971003T090000", "19971107T090000", "19971205T090000", "19980102T090000", "19980206T090000"}},
+		{"19970922T090000", "FREQ=MONTHLY;COUNT=6;BYDAY=-2MO", []string{"19970922T090000",
+			"19971020T090000", "19971117T090000", "19971222T090000", "19980119T090000", "19980216T090000"}},
+		{"19970928T090000", "FREQ=MONTHLY;COUNT=6;BYMONTHDAY=-3", []string{"19970928T090000",
+			"19971029T090000", "19971128T090000", "19971229T090000", "19980129T090000", "19980226T090000"}},
+		{"19970930T090000", "FREQ=MONTHLY;COUNT=6;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", []string{
+			"19970930T090000", "19971031T090000", "19971128T090000", "19971231T090000",
+			"19980130T090000", "19980227T090000"}},
+		{"19970904T090000", "FREQ=MONTHLY;COUNT=3;BYDAY=TU,WE,TH;BYSETPOS=3", []string{
+			"19970904T090000", "19971007T090000", "19971106T090000"}},
+		{"20070115T090000", "FREQ=MONTHLY;BYMONTHDAY=15,30;COUNT=5", []string{"20070115T090000",
+			"20070130T090000", "20070215T090000", "20070315T090000", "20070330T090000"}},
+		{"19970610T090000", "FREQ=YEARLY;COUNT=4;BYMONTH=6,7", []string{"19970610T090000",
+			"19970710T090000", "19980610T090000", "19980710T090000"}},
+		{"19970101T090000", "FREQ=YEARLY;INTERVAL=3;COUNT=7;BYYEARDAY=1,100,200", []string{
+			"19970101T090000", "19970410T090000", "19970719T090000", "20000101T090000",
+			"20000409T090000", "20000718T090000", "20030101T090000"}},
+		{"19970519T090000", "FREQ=YEARLY;COUNT=3;BYDAY=20MO", []string{"19970519T090000",
+			"19980518T090000", "19990517T090000"}},
+		{"19970512T090000", "FREQ=YEARLY;COUNT=3;BYWEEKNO=20;BYDAY=MO", []string{"19970512T090000",
+			"19980511T090000", "19990517T090000"}},
+		{"19970313T090000", "FREQ=YEARLY;COUNT=4;BYMONTH=3;BYDAY=TH", []string{"19970313T090000",
+			"19970320T090000", "19970327T090000", "19980305T090000"}},
+		{"19961105T090000", "FREQ=YEARLY;INTERVAL=4;COUNT=3;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8",
+			[]string{"19961105T090000", "20001107T090000", "20041102T090000"}},
+		{"19970902T090000", "FREQ=MONTHLY;COUNT=4;BYDAY=FR;BYMONTHDAY=13", []string{"19980213T090000",
+			"19980313T090000", "19981113T090000", "19990813T090000"}},
+		{"19970902T090000", "FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T210000Z", []string{
+			"19970902T090000", "19970902T120000", "19970902T150000"}},
+		{"19970902T090000", "FREQ=MINUTELY;INTERVAL=15;COUNT=6", []string{"19970902T090000",
+			"19970902T091500", "19970902T093000", "19970902T094500", "19970902T100000", "19970902T101500"}},
+		{"19970902T090000", "FREQ=DAILY;BYHOUR=9,10,11;BYMINUTE=0,20,40;COUNT=4", []string{
+			"19970902T090000", "19970902T092000", "19970902T094000", "19970902T100000"}},
+		{"19970902T090000", "FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10,11;COUNT=4", []string{
+			"19970902T090000", "19970902T092000", "19970902T094000", "19970902T100000"}},
+	}
+
+	for _, ex := range examples {
+		ex := ex
+		It("should evaluate "+ex.rule, func() {
+			Expect(rule(ex.start, ex.rule).All()).To(Equal(times(ex.expected...)))
+		})
+	}
+
+	It("should keep the wall clock time when daylight savings time ends", func() {
+		r := rule("19971025T090000", "FREQ=DAILY;COUNT=3")
+		Expect(r.All()).To(Equal(times("19971025T090000", "19971026T090000", "19971027T090000")))
+		Expect(r.All()[1].Sub(r.All()[0])).To(Equal(25 * time.Hour))
+	})
+
+	It("should count elapsed hours when daylight savings time ends", func() {
+		r := rule("19971026T000000", "FREQ=HOURLY;COUNT=4")
+		all := r.All()
+		for i := 1; i < len(all); i++ {
+			Expect(all[i].Sub(all[i-1])).To(Equal(time.Hour))
+		}
+		Expect(all[3].Hour()).To(Equal(2))
+	})
+
+	It("should include an UNTIL that is an occurrence", func() {
+		r := rule("19970902T090000", "FREQ=DAILY;UNTIL=19971224T140000Z")
+		all := r.All()
+		Expect(all).To(HaveLen(114))
+		Expect(all[113]).To(Equal(times("19971224T090000")[0]))
+	})
+
+	It("should end when the rule can never be satisfied", func() {
+		Expect(rule("19970902T090000", "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30").All()).To(BeEmpty())
+	})
+
+	It("should end when a SECONDLY rule can never be satisfied", func() {
+		Expect(rule("19970902T090000", "FREQ=SECONDLY;INTERVAL=2;BYSECOND=1").All()).To(BeEmpty())
+		Expect(rule("19970902T090000", "FREQ=MINUTELY;INTERVAL=2;BYMINUTE=1").All()).To(BeEmpty())
+	})
+
+	It("should find rare occurrences of frequent rules", func() {
+		r := rule("19970902T090000", "FREQ=SECONDLY;BYMONTH=2;BYMONTHDAY=29;BYDAY=MO;BYHOUR=23;BYMINUTE=59;BYSECOND=59;COUNT=2")
+		Expect(r.All()).To(Equal(times("20160229T235959", "20440229T235959")))
+	})
+
+	It("should stop All after MaxOccurrences", func() {
+		defer func(n int) { MaxOccurrences = n }(MaxOccurrences)
+		MaxOccurrences = 5
+		Expect(rule("19970902T090000", "FREQ=DAILY").All()).To(HaveLen(5))
+	})
+
+	Describe("Between, After, and Before", func() {
+		r := rule("19970902T090000", "FREQ=WEEKLY;BYDAY=TU")
+		sep9, sep16, sep23 := times("19970909T090000")[0], times("19970916T090000")[0], times("19970923T090000")[0]
+
+		It("should find the occurrences between two times", func() {
+			Expect(r.Between(sep9, sep23, false)).To(Equal([]time.Time{sep16}))
+			Expect(r.Between(sep9, sep23, true)).To(Equal([]time.Time{sep9, sep16, sep23}))
+		})
+
+		It("should find the occurrence after a time", func() {
+			Expect(r.After(sep9, false)).To(Equal(sep16))
+			Expect(r.After(sep9, true)).To(Equal(sep9))
+			Expect(rule("19970902T090000", "FREQ=DAILY;COUNT=1").After(sep9, false).IsZero()).To(BeTrue())
+		})
+
+		It("should find the occurrence before a time", func() {
+			Expect(r.Before(sep16, false)).To(Equal(sep9))
+			Expect(r.Before(sep16, true)).To(Equal(sep16))
+			Expect(r.Before(sep9.AddDate(0, 0, -10), true).IsZero()).To(BeTrue())
+		})
+	})
+
+	It("should iterate over the occurrences", func() {
+		var res []time.Time
+		for it := rule("19970902T090000", "FREQ=DAILY;COUNT=3").Iterator(); it.Next(); {
+			res = append(res, it.Time())
+		}
+		Expect(res).To(Equal(times("19970902T090000", "19970903T090000", "19970904T090000")))
+	})
+})
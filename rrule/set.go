@@ -0,0 +1,94 @@
+package rrule
+
+import (
+	"time"
+)
+
+// Set is the recurrence of an iCalendar event: the occurrences of any number
+// of Rules plus the RDates, minus the ExDates. An occurrence that more than
+// one of them produce is only included once.
+type Set struct {
+	Rules   []Rule
+	RDates  []time.Time
+	ExDates []time.Time
+}
+
+// Iterator returns an iterator over the occurrences of the set.
+func (s Set) Iterator() *Iterator {
+	rules := make([]*Iterator, len(s.Rules))
+	heads := make([]time.Time, len(s.Rules))
+	ok := make([]bool, len(s.Rules))
+	for i, r := range s.Rules {
+		rules[i] = r.Iterator()
+		ok[i] = rules[i].Next()
+		heads[i] = rules[i].Time()
+	}
+
+	rdates := append([]time.Time(nil), s.RDates...)
+	sortTimes(rdates)
+	exdates := make(map[int64]bool, len(s.ExDates))
+	for _, t := range s.ExDates {
+		exdates[t.UnixNano()] = true
+	}
+
+	var last time.Time
+	var started bool
+	next := func() (time.Time, bool) {
+		for {
+			// Find the earliest of the rules' next occurrences and the next RDATE
+			idx := -1
+			var t time.Time
+			for i := range rules {
+				if ok[i] && (idx < 0 || heads[i].Before(t)) {
+					idx, t = i, heads[i]
+				}
+			}
+			if len(rdates) > 0 && (idx < 0 || rdates[0].Before(t)) {
+				idx, t = len(rules), rdates[0]
+			}
+
+			switch {
+			case idx < 0:
+				return time.Time{}, false
+			case idx == len(rules):
+				rdates = rdates[1:]
+			default:
+				ok[idx] = rules[idx].Next()
+				heads[idx] = rules[idx].Time()
+			}
+
+			if (started && t.Equal(last)) || exdates[t.UnixNano()] {
+				continue
+			}
+			last, started = t, true
+			return t, true
+		}
+	}
+	return &Iterator{next: next}
+}
+
+// All returns every occurrence of the set. Sets with a rule that never
+// ends never end either, so All stops after MaxOccurrences.
+func (s Set) All() []time.Time {
+	return all(s.Iterator())
+}
+
+// Between returns the occurrences of the set after <after> and before <before>.
+// If inclusive is true, occurrences equal to either of them are included as well.
+func (s Set) Between(after, before time.Time, inclusive bool) []time.Time {
+	return between(s.Iterator(), after, before, inclusive)
+}
+
+// After returns the first occurrence of the set after t, or the zero
+// time if there is none. If inclusive is true, an occurrence equal to t
+// is returned as well.
+func (s Set) After(t time.Time, inclusive bool) time.Time {
+	return after(s.Iterator(), t, inclusive)
+}
+
+// Before returns the last occurrence of the set before t, or the zero
+// time if there is none. If inclusive is true, an occurrence equal to t
+// is returned as well.
+func (s Set) Before(t time.Time, inclusive bool) time.Time {
+	return before(s.Iterator(), t, inclusive)
+}
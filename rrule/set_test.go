@@ -0,0 +1,46 @@
+package rrule_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/onwsk8r/gotime/rrule"
+)
+
+var _ = Describe("Set", func() {
+	It("should combine rules and dates in order without duplicates", func() {
+		s := Set{
+			Rules: []Rule{
+				rule("19970902T090000", "FREQ=WEEKLY;COUNT=3"),
+				rule("19970902T090000", "FREQ=WEEKLY;COUNT=2;BYDAY=TH"),
+			},
+			RDates: times("19970916T090000", "19970903T120000"),
+		}
+		Expect(s.All()).To(Equal(times("19970902T090000", "19970903T120000", "19970904T090000",
+			"19970909T090000", "19970911T090000", "19970916T090000")))
+	})
+
+	It("should exclude the EXDATEs", func() {
+		s := Set{
+			Rules:   []Rule{rule("19970902T090000", "FREQ=DAILY;COUNT=5")},
+			RDates:  times("19970903T090000"),
+			ExDates: []time.Time{times("19970903T090000")[0].UTC(), times("19970905T090000")[0]},
+		}
+		Expect(s.All()).To(Equal(times("19970902T090000", "19970904T090000", "19970906T090000")))
+	})
+
+	It("should find occurrences between, after, and before times", func() {
+		s := Set{Rules: []Rule{rule("19970902T090000", "FREQ=DAILY")}, ExDates: times("19970904T090000")}
+		sep2, sep3, sep5 := times("19970902T090000")[0], times("19970903T090000")[0], times("19970905T090000")[0]
+		Expect(s.Between(sep2, sep5, false)).To(Equal([]time.Time{sep3}))
+		Expect(s.After(sep3, false)).To(Equal(sep5))
+		Expect(s.Before(sep5, false)).To(Equal(sep3))
+	})
+
+	It("should list RDATEs without any rules", func() {
+		Expect(Set{RDates: times("19970903T090000", "19970902T090000")}.All()).
+			To(Equal(times("19970902T090000", "19970903T090000")))
+	})
+})
@@ -48,6 +48,31 @@ func FirstWeekday(year int, month time.Month, day time.Weekday) time.Time {
 	return t.Add(time.Duration(first-1) * 24 * time.Hour)
 }
 
+// WeekdayBefore returns a UTC time.Time representing the last <day> before
+// the given date, eg the Monday before May 25 (Victoria Day in Canada).
+// If the date is itself a <day>, the one a week earlier is returned.
+func WeekdayBefore(year int, month time.Month, date int, day time.Weekday) time.Time {
+	t := time.Date(year, month, date, 0, 0, 0, 0, time.UTC)
+
+	back := (int(t.Weekday()) - int(day) + 6) % 7
+	return t.AddDate(0, 0, -back-1)
+}
+
+// ISOWeekDate returns a UTC time.Time representing <day> of ISO week <week>
+// of <year>. It is the inverse of time.Time.ISOWeek(): weeks start on Monday
+// and week 1 is the week containing January 4th, so the date may fall in the
+// previous or following calendar year. Weeks outside the year roll over
+// into the neighboring years.
+func ISOWeekDate(year, week int, day time.Weekday) time.Time {
+	t := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+
+	// Days since Monday, where Sunday is the seventh day
+	monday := (int(t.Weekday()) + 6) % 7
+	weekday := (int(day) + 6) % 7
+
+	return t.AddDate(0, 0, 7*(week-1)+weekday-monday)
+}
+
 // Below is a SQL version of the NthWeekday function
 // from which that function was derived
 
@@ -49,6 +49,18 @@ var _ = Describe("Search Functions", func() {
 		})
 	})
 
+	Describe("WeekdayBefore", func() {
+		It("should find Victoria Day", func() {
+			Expect(WeekdayBefore(2018, time.May, 25, time.Monday).Format("20060102")).To(Equal("20180521"))
+			Expect(WeekdayBefore(2020, time.May, 25, time.Monday).Format("20060102")).To(Equal("20200518"))
+			Expect(WeekdayBefore(2019, time.May, 25, time.Monday).Format("20060102")).To(Equal("20190520"))
+		})
+		It("should cross into the previous month", func() {
+			res := WeekdayBefore(2018, time.September, 1, time.Friday)
+			Expect(res.Equal(time.Date(2018, time.August, 31, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+	})
+
 	Describe("LastWeekday", func() {
 		It("should find the last Sunday of a month that starts on Saturday", func() {
 			exp := time.Date(2018, time.September, 30, 0, 0, 0, 0, time.UTC)
@@ -75,6 +87,24 @@ var _ = Describe("Search Functions", func() {
 			Expect(res.Format("20060102")).To(Equal("20180831"))
 		})
 	})
+
+	Describe("ISOWeekDate", func() {
+		It("should find a date in the previous calendar year", func() {
+			exp := time.Date(2008, time.December, 29, 0, 0, 0, 0, time.UTC)
+			Expect(ISOWeekDate(2009, 1, time.Monday)).To(Equal(exp))
+		})
+		It("should find a date in week 53", func() {
+			exp := time.Date(2010, time.January, 3, 0, 0, 0, 0, time.UTC)
+			Expect(ISOWeekDate(2009, 53, time.Sunday)).To(Equal(exp))
+		})
+		It("should be the inverse of ISOWeek", func() {
+			t := time.Date(2015, time.December, 1, 0, 0, 0, 0, time.UTC)
+			for ; t.Year() < 2021; t = t.AddDate(0, 0, 1) {
+				y, w := t.ISOWeek()
+				Expect(ISOWeekDate(y, w, t.Weekday())).To(Equal(t))
+			}
+		})
+	})
 })
 
 func ExampleNthWeekday() {